@@ -0,0 +1,105 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// SchwarzschildRadius returns the event horizon radius in meters of a
+// non-rotating mass, 2GM/c². A solar mass gives about 2953 m. Negative mass
+// gives 0.
+func SchwarzschildRadius(massKg float64) float64 {
+	if massKg < 0 {
+		return 0
+	}
+	return 2 * GravitationalConst * massKg / (SpeedOfLight * SpeedOfLight)
+}
+
+// SchwarzschildRadiusChecked is SchwarzschildRadius but rejects negative
+// mass.
+func SchwarzschildRadiusChecked(massKg float64) (float64, error) {
+	if massKg < 0 {
+		return 0, fmt.Errorf("schwarzschild radius of %g kg: %w", massKg, ErrNegativeMass)
+	}
+	return SchwarzschildRadius(massKg), nil
+}
+
+// SchwarzschildRadius is the typed form of SchwarzschildRadius.
+func (m Kilograms) SchwarzschildRadius() Meters {
+	return Meters(SchwarzschildRadius(float64(m)))
+}
+
+// IsBlackHole reports whether an object of massKg compressed within radiusM
+// lies inside its own Schwarzschild radius.
+func IsBlackHole(massKg, radiusM float64) bool {
+	return massKg > 0 && radiusM <= SchwarzschildRadius(massKg)
+}
+
+// TimeDilationFactor returns the rate of a static clock at radiusM from
+// massKg relative to one far away, √(1 - 2GM/(rc²)) from the Schwarzschild
+// metric. It falls to 0 at the horizon and approaches 1 far from the mass.
+// Radii at or inside the horizon, and negative mass, give 0.
+func TimeDilationFactor(massKg, radiusM float64) float64 {
+	if massKg < 0 {
+		return 0
+	}
+	rs := SchwarzschildRadius(massKg)
+	if radiusM <= rs {
+		return 0
+	}
+	return math.Sqrt(1 - rs/radiusM)
+}
+
+// TimeDilationFactorChecked is TimeDilationFactor but rejects negative mass
+// and radii at or inside the horizon.
+func TimeDilationFactorChecked(massKg, radiusM float64) (float64, error) {
+	if massKg < 0 {
+		return 0, fmt.Errorf("time dilation near %g kg: %w", massKg, ErrNegativeMass)
+	}
+	if radiusM <= SchwarzschildRadius(massKg) {
+		return 0, fmt.Errorf("time dilation at radius %g m: %w", radiusM, ErrInsideHorizon)
+	}
+	return TimeDilationFactor(massKg, radiusM), nil
+}
+
+// HawkingTemperature returns the temperature in kelvin of the Hawking
+// radiation from a black hole of massKg, ħc³/(8πGMk). It falls as the hole
+// grows: a solar mass gives about 6e-8 K, far colder than the CMB.
+// Non-positive mass gives 0.
+func HawkingTemperature(massKg float64) float64 {
+	if massKg <= 0 {
+		return 0
+	}
+	c3 := SpeedOfLight * SpeedOfLight * SpeedOfLight
+	return reducedPlanck * c3 / (8 * math.Pi * GravitationalConst * massKg * BoltzmannConstant)
+}
+
+// HawkingTemperature is the typed form of HawkingTemperature.
+func (m Kilograms) HawkingTemperature() Kelvin {
+	return Kelvin(HawkingTemperature(float64(m)))
+}
+
+// EvaporationTime returns the time in seconds a black hole of massKg takes
+// to evaporate completely by Hawking radiation, 5120πG²M³/(ħc⁴). It counts
+// massless emission only, and ignores the CMB a hole colder than the
+// background absorbs faster than it radiates. Non-positive mass gives 0.
+func EvaporationTime(massKg float64) float64 {
+	if massKg <= 0 {
+		return 0
+	}
+	c2 := SpeedOfLight * SpeedOfLight
+	return 5120 * math.Pi * GravitationalConst * GravitationalConst *
+		massKg * massKg * massKg / (reducedPlanck * c2 * c2)
+}
+
+// EvaporationTime is the typed form of EvaporationTime.
+func (m Kilograms) EvaporationTime() Seconds {
+	return Seconds(EvaporationTime(float64(m)))
+}
+
+// HasEvaporated reports whether a primordial black hole of massKg, formed
+// at the Big Bang, has evaporated by ageMyr. Today that takes a mass below
+// about 1.7e11 kg.
+func HasEvaporated(massKg, ageMyr float64) bool {
+	return massKg > 0 && EvaporationTime(massKg) <= ageMyr*secondsPerMyr
+}
@@ -0,0 +1,23 @@
+package universe
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestTimeDilationFactor(t *testing.T) {
+	rs := SchwarzschildRadius(solarMass)
+	if got := TimeDilationFactor(solarMass, 1e12*rs); math.Abs(got-1) > 1e-11 {
+		t.Errorf("factor far from the hole = %g, want → 1", got)
+	}
+	if got := TimeDilationFactor(solarMass, 4*rs); math.Abs(got-math.Sqrt(0.75)) > 1e-12 {
+		t.Errorf("factor at 4 r_s = %g, want √(3/4)", got)
+	}
+	if got := TimeDilationFactor(solarMass, rs); got != 0 {
+		t.Errorf("factor at the horizon = %g, want 0", got)
+	}
+	if _, err := TimeDilationFactorChecked(solarMass, rs); !errors.Is(err, ErrInsideHorizon) {
+		t.Errorf("checked factor at the horizon: %v, want ErrInsideHorizon", err)
+	}
+}
@@ -0,0 +1,86 @@
+package universe
+
+import "math"
+
+// The temperature range BlackbodyRGB distinguishes. Cooler and hotter
+// bodies take the colour at the nearer end.
+const (
+	minColorTempK = 1000
+	maxColorTempK = 40000
+)
+
+// The visible band the colour-matching functions are integrated over, nm.
+const (
+	visibleMinNm = 380
+	visibleMaxNm = 780
+)
+
+// colorTolerance is the relative accuracy of the tristimulus integrals.
+const colorTolerance = 1e-6
+
+// cieLobe is one asymmetric Gaussian of a colour-matching function fit:
+// weight·exp(-½((λ-mean)/σ)²), with σ = below under the mean and above
+// over it.
+type cieLobe struct {
+	weight, mean, below, above float64
+}
+
+func (l cieLobe) at(nm float64) float64 {
+	s := l.above
+	if nm < l.mean {
+		s = l.below
+	}
+	t := (nm - l.mean) / s
+	return l.weight * math.Exp(-t*t/2)
+}
+
+// The CIE 1931 2° colour-matching functions x̄, ȳ, z̄ as the multi-lobe
+// Gaussian fits of Wyman, Sloan and Shirley (2013), in nm.
+var (
+	cieX = []cieLobe{{1.056, 599.8, 37.9, 31.0}, {0.362, 442.0, 16.0, 26.7}, {-0.065, 501.1, 20.4, 26.2}}
+	cieY = []cieLobe{{0.821, 568.8, 46.9, 40.5}, {0.286, 530.9, 16.3, 31.1}}
+	cieZ = []cieLobe{{1.217, 437.0, 11.8, 36.0}, {0.681, 459.0, 26.0, 13.8}}
+)
+
+// tristimulus integrates the Planck spectrum at tempK against the lobes.
+func tristimulus(lobes []cieLobe, tempK float64) float64 {
+	integrand := func(nm float64) float64 {
+		var cmf float64
+		for _, l := range lobes {
+			cmf += l.at(nm)
+		}
+		return cmf * SpectralRadiance(nm*1e-9, tempK)
+	}
+	v, _ := integrate(integrand, visibleMinNm, visibleMaxNm, colorTolerance)
+	return v
+}
+
+// BlackbodyRGB returns the sRGB colour of a blackbody at tempK: its Planck
+// spectrum seen through the CIE 1931 colour-matching functions, converted
+// to linear sRGB, scaled so the brightest channel is full and gamma
+// encoded. Only the hue is kept, not the brightness. A 3000 K star comes
+// out orange, the 5800 K Sun a warm white and a 10000 K star a pale
+// blue. Temperatures are clamped to 1000–40000 K.
+func BlackbodyRGB(tempK float64) (r, g, b uint8) {
+	if math.IsNaN(tempK) {
+		tempK = minColorTempK
+	}
+	tempK = min(max(tempK, minColorTempK), maxColorTempK)
+	x := tristimulus(cieX, tempK)
+	y := tristimulus(cieY, tempK)
+	z := tristimulus(cieZ, tempK)
+	lr := max(3.2406*x-1.5372*y-0.4986*z, 0)
+	lg := max(-0.9689*x+1.8758*y+0.0415*z, 0)
+	lb := max(0.0557*x-0.2040*y+1.0570*z, 0)
+	peak := max(lr, lg, lb)
+	return srgbByte(lr / peak), srgbByte(lg / peak), srgbByte(lb / peak)
+}
+
+// srgbByte gamma encodes a linear sRGB channel in [0, 1] as a byte.
+func srgbByte(linear float64) uint8 {
+	v := 12.92 * linear
+	if linear > 0.0031308 {
+		v = 1.055*math.Pow(linear, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(255 * min(max(v, 0), 1)))
+}
@@ -0,0 +1,36 @@
+package universe
+
+import "testing"
+
+func TestBlackbodyRGB(t *testing.T) {
+	for _, tc := range []struct {
+		tempK   float64
+		r, g, b uint8
+	}{
+		{1000, 255, 47, 0},
+		{3000, 255, 185, 110},  // reddish
+		{5800, 255, 241, 235},  // whitish
+		{10000, 205, 217, 255}, // bluish
+		{40000, 159, 184, 255},
+	} {
+		r, g, b := BlackbodyRGB(tc.tempK)
+		if r != tc.r || g != tc.g || b != tc.b {
+			t.Errorf("BlackbodyRGB(%g) = (%d, %d, %d), want (%d, %d, %d)", tc.tempK, r, g, b, tc.r, tc.g, tc.b)
+		}
+	}
+}
+
+func TestBlackbodyRGBClamps(t *testing.T) {
+	for _, tc := range []struct{ tempK, clampedK float64 }{
+		{0, minColorTempK},
+		{500, minColorTempK},
+		{1e6, maxColorTempK},
+	} {
+		r, g, b := BlackbodyRGB(tc.tempK)
+		wr, wg, wb := BlackbodyRGB(tc.clampedK)
+		if r != wr || g != wg || b != wb {
+			t.Errorf("BlackbodyRGB(%g) = (%d, %d, %d), want the %g K colour (%d, %d, %d)",
+				tc.tempK, r, g, b, tc.clampedK, wr, wg, wb)
+		}
+	}
+}
@@ -14,10 +14,11 @@ const (
     BigBangCommit      int64   = 1               // The first commit
     
     // Cosmological parameters
-    HubbleConstant     float64 = 70.0            // km/s/Mpc
-    DarkEnergyFraction float64 = 0.68
-    DarkMatterFraction float64 = 0.27
-    BaryonicFraction   float64 = 0.05
+    HubbleConstant      float64 = 70.0            // km/s/Mpc
+    DarkEnergyFraction  float64 = 0.68
+    DarkMatterFraction  float64 = 0.27
+    BaryonicFraction    float64 = 0.05
+    CMBTemperatureToday float64 = 2.725           // K
 )
 
 // Current state - updated by the engine
@@ -0,0 +1,618 @@
+package universe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// effectiveNeutrinoSpecies is the standard-model N_eff, counting the three
+// neutrino flavours plus the small boost from incomplete decoupling.
+const effectiveNeutrinoSpecies = 3.046
+
+// neutrinoTemperatureRatio is T_ν/T_γ = (4/11)^(1/3). The photons were
+// heated by e+e- annihilation after the neutrinos had already decoupled.
+var neutrinoTemperatureRatio = math.Cbrt(4.0 / 11.0)
+
+// neutrinoDecouplingEnergy is the thermal energy kT, 1 MeV, at which the
+// weak interactions fell out of equilibrium.
+const neutrinoDecouplingEnergy = 1e6 * ElementaryCharge
+
+// radiationConstant is a = 4σ/c: the photon energy density per T⁴.
+const radiationConstant = 4 * StefanBoltzmannConstant / SpeedOfLight
+
+var (
+	// ErrUnphysicalRedshift is returned for redshifts below -1, which would
+	// need a negative scale factor.
+	ErrUnphysicalRedshift = errors.New("universe: redshift below -1 is unphysical")
+	// ErrFutureRedshift is returned for negative redshifts, which lie beyond
+	// the present epoch.
+	ErrFutureRedshift = errors.New("universe: redshift lies beyond the present epoch")
+)
+
+// cosmicTimeTolerance is the relative accuracy of the age integral.
+const cosmicTimeTolerance = 1e-12
+
+// Cosmology is a set of cosmological parameters. The package-level
+// functions use DefaultCosmology; build a different Cosmology to explore an
+// alternate universe.
+//
+// H0, the density fractions and CMBTempToday all describe one epoch, today,
+// where the scale factor is 1. How long the universe takes to get there
+// follows from them; see PresentAge.
+type Cosmology struct {
+	H0           float64 `json:"h0"`             // Hubble constant today, km/s/Mpc
+	OmegaLambda  float64 `json:"omega_lambda"`   // dark energy fraction
+	OmegaMatter  float64 `json:"omega_matter"`   // matter fraction, baryons included
+	OmegaBaryon  float64 `json:"omega_baryon"`   // baryonic share of OmegaMatter
+	CMBTempToday float64 `json:"cmb_temp_today"` // CMB temperature today, K
+
+	// ReionizationRedshift is the midpoint of reionization, when half the
+	// intergalactic hydrogen has been ionized again by the first stars.
+	ReionizationRedshift float64 `json:"reionization_redshift"`
+
+	Inflation Inflation `json:"inflation"`
+}
+
+// DefaultCosmology returns the cosmology of this universe, built from the
+// constants in constants.go.
+func DefaultCosmology() Cosmology {
+	return Cosmology{
+		H0:           HubbleConstant,
+		OmegaLambda:  DarkEnergyFraction,
+		OmegaMatter:  DarkMatterFraction + BaryonicFraction,
+		OmegaBaryon:  BaryonicFraction,
+		CMBTempToday: CMBTemperatureToday,
+
+		ReionizationRedshift: 7.7,
+
+		Inflation: DefaultInflation(),
+	}
+}
+
+// hubbleSI returns H0 in s⁻¹.
+func (c Cosmology) hubbleSI() float64 {
+	return c.H0 * 1000 / metersPerMpc
+}
+
+// hubblePerMyr returns H0 in Myr⁻¹.
+func (c Cosmology) hubblePerMyr() float64 {
+	return c.hubbleSI() * secondsPerMyr
+}
+
+// CriticalDensity returns the present critical density 3H0²/(8πG) in
+// kg/m³, the density at which the universe is exactly flat.
+func (c Cosmology) CriticalDensity() float64 {
+	h0 := c.hubbleSI()
+	return 3 * h0 * h0 / (8 * math.Pi * GravitationalConst)
+}
+
+// flatnessTolerance is how far OmegaTotal may stray from 1 and still count
+// as flat, allowing for rounding in the fractions.
+const flatnessTolerance = 1e-9
+
+// OmegaTotal returns the sum of the dark energy and matter fractions, which
+// is 1 for a flat universe.
+func (c Cosmology) OmegaTotal() float64 {
+	return c.OmegaLambda + c.OmegaMatter
+}
+
+// OmegaCurvature returns Ωk = 1 - OmegaTotal: positive for an open universe,
+// negative for a closed one.
+func (c Cosmology) OmegaCurvature() float64 {
+	return 1 - c.OmegaTotal()
+}
+
+// IsFlat reports whether the density fractions sum to 1.
+func (c Cosmology) IsFlat() bool {
+	return math.Abs(c.OmegaCurvature()) <= flatnessTolerance
+}
+
+// omegaPhoton returns the present density parameter of the CMB photons.
+func (c Cosmology) omegaPhoton() float64 {
+	t2 := c.CMBTempToday * c.CMBTempToday
+	return radiationConstant * t2 * t2 / (SpeedOfLight * SpeedOfLight) / c.CriticalDensity()
+}
+
+// omegaRadiation returns the present radiation density parameter: the CMB
+// photons plus the relic neutrinos, which are colder by (4/11)^(1/3).
+func (c Cosmology) omegaRadiation() float64 {
+	neutrinos := effectiveNeutrinoSpecies * 7.0 / 8.0 * math.Pow(neutrinoTemperatureRatio, 4)
+	return c.omegaPhoton() * (1 + neutrinos)
+}
+
+// omegaLambda returns the present dark energy density parameter, the dark
+// energy fraction less the radiation it makes room for.
+func (c Cosmology) omegaLambda() float64 {
+	return c.OmegaLambda - c.omegaRadiation()
+}
+
+// expansionRate2 returns E²(a) = H²(a)/H0². Radiation rides on top of the
+// budget fractions and is taken out of the dark energy share, so a budget
+// that sums to one stays flat. Writing each term relative to its present
+// value makes E(1) exactly 1 whatever the fractions are.
+func (c Cosmology) expansionRate2(a float64) float64 {
+	return 1 +
+		c.OmegaMatter*(1/(a*a*a)-1) +
+		c.omegaRadiation()*(1/(a*a*a*a)-1) +
+		c.OmegaCurvature()*(1/(a*a)-1)
+}
+
+// cosmicTime returns the age in Myr at which the Friedmann solution reaches
+// scale factor a: t(a) = ∫₀ᵃ da'/(a' H(a')).
+func (c Cosmology) cosmicTime(a float64) float64 {
+	if a <= 0 {
+		return 0
+	}
+	om, or, ok := c.OmegaMatter, c.omegaRadiation(), c.OmegaCurvature()
+	// a/√(a⁴E²) avoids the 1/a⁴ pole at the Big Bang; it tends to zero there,
+	// but without radiation only as √a, so a = 0 itself would be 0/0.
+	integrand := func(x float64) float64 {
+		if x == 0 {
+			return 0
+		}
+		x2 := x * x
+		x4 := x2 * x2
+		return x / math.Sqrt(x4+om*(x-x4)+or*(1-x4)+ok*(x2-x4))
+	}
+	t, _ := integrate(integrand, 0, a, cosmicTimeTolerance)
+	return t / c.hubblePerMyr()
+}
+
+// ScaleFactor returns the cosmic scale factor a at ageMyr for a universe
+// with matter, radiation and dark energy, normalized so that a is 1 today,
+// at PresentAge. It is 0 at and before the Big Bang and strictly increasing
+// afterwards, except that a closed universe stays at its largest scale
+// factor once it reaches the turnaround; its recollapse is not followed. It
+// inverts cosmicTime with a bisection-guarded Newton
+// iteration in ln a, where dt/d(ln a) = 1/H.
+func (c Cosmology) ScaleFactor(ageMyr float64) float64 {
+	if ageMyr <= 0 {
+		return 0
+	}
+	if math.IsInf(ageMyr, 1) {
+		return ageMyr
+	}
+	// Past the turnaround of a closed universe E² is negative and the age
+	// is NaN; the bracket treats that as too far, like an age beyond ageMyr.
+	lo, hi := -300.0, 0.0
+	for t := c.cosmicTime(1); t < ageMyr; t = c.cosmicTime(math.Exp(hi)) {
+		lo = hi
+		hi++
+	}
+	h0 := c.hubblePerMyr()
+	u := hi
+	for i := 0; i < 200; i++ {
+		a := math.Exp(u)
+		diff := c.cosmicTime(a) - ageMyr
+		if diff > 0 || math.IsNaN(diff) {
+			hi = u
+		} else {
+			lo = u
+		}
+		next := u - diff*h0*math.Sqrt(c.expansionRate2(a))
+		if !(next > lo && next < hi) {
+			next = (lo + hi) / 2
+		}
+		if math.Abs(next-u) < 1e-14 {
+			u = next
+			break
+		}
+		u = next
+	}
+	return math.Exp(u)
+}
+
+// maxCachedCosmologies bounds presentAges; a parameter sweep past it starts
+// the cache afresh.
+const maxCachedCosmologies = 256
+
+// presentAges caches PresentAge per Cosmology. Each one costs a full age
+// integral, and the engine needs it on every step.
+var presentAges struct {
+	sync.Mutex
+	m map[Cosmology]float64
+}
+
+// PresentAge returns the age of the universe today in Myr, the age at which
+// the scale factor reaches 1 and the expansion rate is H0. It follows from
+// the parameters rather than being fixed: about 13200 Myr for
+// DefaultCosmology's H0 of 70.
+func (c Cosmology) PresentAge() float64 {
+	cache := &presentAges
+	cache.Lock()
+	age, ok := cache.m[c]
+	cache.Unlock()
+	if ok {
+		return age
+	}
+	age = c.cosmicTime(1)
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.m == nil || len(cache.m) >= maxCachedCosmologies {
+		cache.m = make(map[Cosmology]float64)
+	}
+	// A NaN parameter makes c unequal to itself, so it could never be
+	// looked up again; keep it out of the cache.
+	if c == c {
+		cache.m[c] = age
+	}
+	return age
+}
+
+// RedshiftFromAge returns the redshift z = 1/a - 1 of light emitted at
+// ageMyr and observed today. It is +Inf at the Big Bang and negative for
+// ages beyond the present.
+func (c Cosmology) RedshiftFromAge(ageMyr float64) float64 {
+	return 1/c.ScaleFactor(ageMyr) - 1
+}
+
+// AgeFromRedshift returns the age in Myr at which the universe had redshift
+// z, the inverse of RedshiftFromAge. It fails for z < -1 and for any
+// negative z, since those epochs have not happened yet.
+func (c Cosmology) AgeFromRedshift(z float64) (float64, error) {
+	if z < -1 {
+		return 0, fmt.Errorf("age at z = %g: %w", z, ErrUnphysicalRedshift)
+	}
+	if z < 0 {
+		return 0, fmt.Errorf("age at z = %g: %w", z, ErrFutureRedshift)
+	}
+	return c.cosmicTime(1 / (1 + z)), nil
+}
+
+// LookbackTime returns the light travel time in Myr from redshift z, the
+// present age less the age at z. It is 0 at z = 0 and for the future, and
+// approaches the present age as z grows without bound.
+func (c Cosmology) LookbackTime(z float64) float64 {
+	if z <= 0 {
+		return 0
+	}
+	age, _ := c.AgeFromRedshift(z)
+	return c.PresentAge() - age
+}
+
+// HubbleParameter returns the expansion rate H(z) in km/s/Mpc,
+// H0·√(Ωm(1+z)³ + Ωr(1+z)⁴ + ΩΛ), with Ωr derived from the CMB temperature
+// and its relic neutrinos. It returns exactly H0 at z = 0.
+func (c Cosmology) HubbleParameter(z float64) float64 {
+	return c.H0 * math.Sqrt(c.expansionRate2(1/(1+z)))
+}
+
+// CMBTemperature returns the temperature of the cosmic microwave background
+// in kelvin at redshift z, which scales as (1+z). At recombination (z ≈ 1100)
+// this is about 3000 K.
+func (c Cosmology) CMBTemperature(z float64) float64 {
+	return c.CMBTempToday * (1 + z)
+}
+
+// CMBTemperatureChecked is CMBTemperature but rejects redshifts below -1.
+func (c Cosmology) CMBTemperatureChecked(z float64) (float64, error) {
+	if z < -1 {
+		return 0, fmt.Errorf("CMB temperature at z = %g: %w", z, ErrUnphysicalRedshift)
+	}
+	return c.CMBTemperature(z), nil
+}
+
+// NeutrinoTemperature returns the temperature in kelvin of the relic
+// neutrino background at redshift z, (4/11)^(1/3) of the CMB temperature:
+// about 1.95 K today.
+func (c Cosmology) NeutrinoTemperature(z float64) float64 {
+	return neutrinoTemperatureRatio * c.CMBTemperature(z)
+}
+
+// distanceTolerance is the relative accuracy of the distance integrals.
+const distanceTolerance = 1e-10
+
+// hubbleLength returns c/H0 in Mpc.
+func (c Cosmology) hubbleLength() float64 {
+	return SpeedOfLight / 1000 / c.H0
+}
+
+// ComovingDistance returns the line-of-sight comoving distance in Mpc to
+// redshift z, the integral of c/H(z') from 0 to z. It is exactly 0 at z = 0
+// and for the future (z < 0).
+func (c Cosmology) ComovingDistance(z float64) float64 {
+	d, _ := c.comovingDistance(z)
+	return d
+}
+
+// ComovingDistanceChecked is ComovingDistance but rejects negative
+// redshifts, and fails with ErrNoConvergence if the integral misses its
+// tolerance.
+func (c Cosmology) ComovingDistanceChecked(z float64) (float64, error) {
+	if z < -1 {
+		return 0, fmt.Errorf("comoving distance to z = %g: %w", z, ErrUnphysicalRedshift)
+	}
+	if z < 0 {
+		return 0, fmt.Errorf("comoving distance to z = %g: %w", z, ErrFutureRedshift)
+	}
+	d, errEst := c.comovingDistance(z)
+	if !converged(d, errEst, distanceTolerance) {
+		return d, fmt.Errorf("comoving distance to z = %g: error estimate %g Mpc: %w", z, errEst, ErrNoConvergence)
+	}
+	return d, nil
+}
+
+// comovingDistance returns ComovingDistance with the integral's error
+// estimate in Mpc.
+func (c Cosmology) comovingDistance(z float64) (d, errEstimate float64) {
+	if z <= 0 {
+		return 0, 0
+	}
+	integrand := func(x float64) float64 {
+		return 1 / math.Sqrt(c.expansionRate2(1/(1+x)))
+	}
+	d, errEstimate = integrate(integrand, 0, z, distanceTolerance)
+	return c.hubbleLength() * d, c.hubbleLength() * errEstimate
+}
+
+// LuminosityDistance returns the luminosity distance in Mpc to redshift z,
+// (1+z) times the comoving distance.
+func (c Cosmology) LuminosityDistance(z float64) float64 {
+	return (1 + z) * c.ComovingDistance(z)
+}
+
+// AngularDiameterDistance returns the angular diameter distance in Mpc to
+// redshift z, the comoving distance divided by (1+z).
+func (c Cosmology) AngularDiameterDistance(z float64) float64 {
+	return c.ComovingDistance(z) / (1 + z)
+}
+
+// ComovingDistanceTo is ComovingDistance with a typed result.
+func (c Cosmology) ComovingDistanceTo(z float64) Megaparsecs {
+	return Megaparsecs(c.ComovingDistance(z))
+}
+
+// LuminosityDistanceTo is LuminosityDistance with a typed result.
+func (c Cosmology) LuminosityDistanceTo(z float64) Megaparsecs {
+	return Megaparsecs(c.LuminosityDistance(z))
+}
+
+// AngularDiameterDistanceTo is AngularDiameterDistance with a typed result.
+func (c Cosmology) AngularDiameterDistanceTo(z float64) Megaparsecs {
+	return Megaparsecs(c.AngularDiameterDistance(z))
+}
+
+// MatterRadiationEquality returns the redshift at which the matter and
+// radiation densities were equal, Ωm/Ωr - 1. Before it radiation drove the
+// expansion.
+func (c Cosmology) MatterRadiationEquality() float64 {
+	return c.OmegaMatter/c.omegaRadiation() - 1
+}
+
+// MatterLambdaEquality returns the redshift at which the matter and dark
+// energy densities were equal, (ΩΛ/Ωm)^(1/3) - 1. After it dark energy
+// drives the expansion.
+func (c Cosmology) MatterLambdaEquality() float64 {
+	return math.Cbrt(c.omegaLambda()/c.OmegaMatter) - 1
+}
+
+// MatterRadiationEqualityAge returns the age in Myr at matter-radiation
+// equality.
+func (c Cosmology) MatterRadiationEqualityAge() (float64, error) {
+	return c.AgeFromRedshift(c.MatterRadiationEquality())
+}
+
+// MatterLambdaEqualityAge returns the age in Myr at matter-dark energy
+// equality. It fails if dark energy never catches up with matter.
+func (c Cosmology) MatterLambdaEqualityAge() (float64, error) {
+	return c.AgeFromRedshift(c.MatterLambdaEquality())
+}
+
+// RecessionVelocity returns the Hubble-flow recession velocity in km/s of
+// a galaxy distanceMpc away, H0·d. At 100 Mpc this is 7000 km/s for
+// DefaultCosmology.
+func (c Cosmology) RecessionVelocity(distanceMpc float64) float64 {
+	return c.H0 * distanceMpc
+}
+
+// HubbleDistance returns the distance in Mpc at which the Hubble flow
+// recedes at velocityKmS, the inverse of RecessionVelocity.
+func (c Cosmology) HubbleDistance(velocityKmS float64) float64 {
+	return velocityKmS / c.H0
+}
+
+// HubbleTime returns 1/H0 in Myr, the age the universe would have if it had
+// always expanded at today's rate. It is close to the true age.
+func (c Cosmology) HubbleTime() float64 {
+	return 1 / c.hubblePerMyr()
+}
+
+// DragRedshift returns the redshift of the drag epoch, when baryons were
+// released from the photons' pull shortly after recombination. It uses the
+// Eisenstein & Hu (1998) fit in the physical densities Ωh².
+func (c Cosmology) DragRedshift() float64 {
+	h := c.H0 / 100
+	wm := c.OmegaMatter * h * h
+	wb := c.OmegaBaryon * h * h
+	b1 := 0.313 * math.Pow(wm, -0.419) * (1 + 0.607*math.Pow(wm, 0.674))
+	b2 := 0.238 * math.Pow(wm, 0.223)
+	return 1291 * math.Pow(wm, 0.251) / (1 + 0.659*math.Pow(wm, 0.828)) * (1 + b1*math.Pow(wb, b2))
+}
+
+// SoundHorizon returns the comoving sound horizon at the drag epoch in Mpc:
+// the distance a pressure wave in the photon-baryon fluid travels from the
+// Big Bang until the baryons are released. It is the standard ruler behind
+// baryon acoustic oscillations, near 150 Mpc for DefaultCosmology.
+func (c Cosmology) SoundHorizon() float64 {
+	r, _ := c.soundHorizon()
+	return r
+}
+
+// SoundHorizonChecked is SoundHorizon but fails with ErrNoConvergence if
+// the integral misses its tolerance.
+func (c Cosmology) SoundHorizonChecked() (float64, error) {
+	r, errEst := c.soundHorizon()
+	if !converged(r, errEst, distanceTolerance) {
+		return r, fmt.Errorf("sound horizon: error estimate %g Mpc: %w", errEst, ErrNoConvergence)
+	}
+	return r, nil
+}
+
+// soundHorizon returns SoundHorizon with the integral's error estimate in
+// Mpc.
+func (c Cosmology) soundHorizon() (r, errEstimate float64) {
+	aDrag := 1 / (1 + c.DragRedshift())
+	// R = 3ρb/(4ργ) is the baryon loading that slows the sound speed below
+	// c/√3; it grows in proportion to a.
+	loading := 3 * c.OmegaBaryon / (4 * c.omegaPhoton())
+	om, or, ok := c.OmegaMatter, c.omegaRadiation(), c.OmegaCurvature()
+	// c_s/(a²H) with a²E = √(a⁴E²), which is finite at a = 0.
+	integrand := func(a float64) float64 {
+		a2 := a * a
+		a4 := a2 * a2
+		cs := 1 / math.Sqrt(3*(1+loading*a))
+		return cs / math.Sqrt(a4+om*(a-a4)+or*(1-a4)+ok*(a2-a4))
+	}
+	r, errEstimate = integrate(integrand, 0, aDrag, distanceTolerance)
+	return c.hubbleLength() * r, c.hubbleLength() * errEstimate
+}
+
+// recombinationRedshift is the midpoint of recombination, when electrons
+// and protons first combined into neutral hydrogen.
+const recombinationRedshift = 1100
+
+// Transition widths of the ionization history. Recombination is a tanh in
+// z; reionization follows the usual tanh in (1+z)^(3/2) with Δz = 0.5.
+const (
+	recombinationWidth = 80
+	reionizationWidth  = 0.5
+)
+
+// NeutralHydrogenFraction returns the fraction of hydrogen that is neutral
+// at redshift z. The gas is ionized above recombination (z ≈ 1100), neutral
+// through the dark ages, and ionized again by reionization, which is half
+// done at ReionizationRedshift and essentially complete a couple of units of
+// z later. Each transition is smooth and monotonic.
+func (c Cosmology) NeutralHydrogenFraction(z float64) float64 {
+	recombined := 0.5 * (1 - math.Tanh((z-recombinationRedshift)/recombinationWidth))
+	y := math.Pow(1+z, 1.5)
+	yre := math.Pow(1+c.ReionizationRedshift, 1.5)
+	dy := 1.5 * math.Sqrt(1+c.ReionizationRedshift) * reionizationWidth
+	reionized := 0.5 * (1 + math.Tanh((yre-y)/dy))
+	return recombined * (1 - reionized)
+}
+
+// The functions below evaluate DefaultCosmology.
+
+// CriticalDensity is DefaultCosmology().CriticalDensity.
+func CriticalDensity() float64 { return DefaultCosmology().CriticalDensity() }
+
+// OmegaTotal is DefaultCosmology().OmegaTotal.
+func OmegaTotal() float64 { return DefaultCosmology().OmegaTotal() }
+
+// OmegaCurvature is DefaultCosmology().OmegaCurvature.
+func OmegaCurvature() float64 { return DefaultCosmology().OmegaCurvature() }
+
+// IsFlat is DefaultCosmology().IsFlat.
+func IsFlat() bool { return DefaultCosmology().IsFlat() }
+
+// PresentAge is DefaultCosmology().PresentAge.
+func PresentAge() float64 { return DefaultCosmology().PresentAge() }
+
+// ScaleFactor is DefaultCosmology().ScaleFactor.
+func ScaleFactor(ageMyr float64) float64 { return DefaultCosmology().ScaleFactor(ageMyr) }
+
+// RedshiftFromAge is DefaultCosmology().RedshiftFromAge.
+func RedshiftFromAge(ageMyr float64) float64 { return DefaultCosmology().RedshiftFromAge(ageMyr) }
+
+// AgeFromRedshift is DefaultCosmology().AgeFromRedshift.
+func AgeFromRedshift(z float64) (float64, error) { return DefaultCosmology().AgeFromRedshift(z) }
+
+// HubbleParameter is DefaultCosmology().HubbleParameter. It returns exactly
+// HubbleConstant at z = 0.
+func HubbleParameter(z float64) float64 { return DefaultCosmology().HubbleParameter(z) }
+
+// CMBTemperature is DefaultCosmology().CMBTemperature.
+func CMBTemperature(z float64) float64 { return DefaultCosmology().CMBTemperature(z) }
+
+// CMBTemperatureChecked is DefaultCosmology().CMBTemperatureChecked.
+func CMBTemperatureChecked(z float64) (float64, error) {
+	return DefaultCosmology().CMBTemperatureChecked(z)
+}
+
+// NeutrinoTemperature is DefaultCosmology().NeutrinoTemperature.
+func NeutrinoTemperature(z float64) float64 { return DefaultCosmology().NeutrinoTemperature(z) }
+
+// NeutrinoDecouplingTemperature returns the temperature in kelvin, about
+// 1.16e10 K, at which neutrinos decoupled from the thermal plasma: kT of
+// roughly 1 MeV, a second or so after the Big Bang.
+func NeutrinoDecouplingTemperature() float64 {
+	return neutrinoDecouplingEnergy / BoltzmannConstant
+}
+
+// LookbackTime is DefaultCosmology().LookbackTime.
+func LookbackTime(z float64) float64 { return DefaultCosmology().LookbackTime(z) }
+
+// ComovingDistance is DefaultCosmology().ComovingDistance.
+func ComovingDistance(z float64) float64 { return DefaultCosmology().ComovingDistance(z) }
+
+// ComovingDistanceChecked is DefaultCosmology().ComovingDistanceChecked.
+func ComovingDistanceChecked(z float64) (float64, error) {
+	return DefaultCosmology().ComovingDistanceChecked(z)
+}
+
+// LuminosityDistance is DefaultCosmology().LuminosityDistance.
+func LuminosityDistance(z float64) float64 { return DefaultCosmology().LuminosityDistance(z) }
+
+// AngularDiameterDistance is DefaultCosmology().AngularDiameterDistance.
+func AngularDiameterDistance(z float64) float64 {
+	return DefaultCosmology().AngularDiameterDistance(z)
+}
+
+// ComovingDistanceTo is DefaultCosmology().ComovingDistanceTo.
+func ComovingDistanceTo(z float64) Megaparsecs { return DefaultCosmology().ComovingDistanceTo(z) }
+
+// LuminosityDistanceTo is DefaultCosmology().LuminosityDistanceTo.
+func LuminosityDistanceTo(z float64) Megaparsecs {
+	return DefaultCosmology().LuminosityDistanceTo(z)
+}
+
+// AngularDiameterDistanceTo is DefaultCosmology().AngularDiameterDistanceTo.
+func AngularDiameterDistanceTo(z float64) Megaparsecs {
+	return DefaultCosmology().AngularDiameterDistanceTo(z)
+}
+
+// MatterRadiationEquality is DefaultCosmology().MatterRadiationEquality.
+func MatterRadiationEquality() float64 { return DefaultCosmology().MatterRadiationEquality() }
+
+// MatterLambdaEquality is DefaultCosmology().MatterLambdaEquality.
+func MatterLambdaEquality() float64 { return DefaultCosmology().MatterLambdaEquality() }
+
+// MatterRadiationEqualityAge is DefaultCosmology().MatterRadiationEqualityAge.
+func MatterRadiationEqualityAge() (float64, error) {
+	return DefaultCosmology().MatterRadiationEqualityAge()
+}
+
+// MatterLambdaEqualityAge is DefaultCosmology().MatterLambdaEqualityAge.
+func MatterLambdaEqualityAge() (float64, error) {
+	return DefaultCosmology().MatterLambdaEqualityAge()
+}
+
+// NeutralHydrogenFraction is DefaultCosmology().NeutralHydrogenFraction.
+func NeutralHydrogenFraction(z float64) float64 {
+	return DefaultCosmology().NeutralHydrogenFraction(z)
+}
+
+// DragRedshift is DefaultCosmology().DragRedshift.
+func DragRedshift() float64 { return DefaultCosmology().DragRedshift() }
+
+// SoundHorizon is DefaultCosmology().SoundHorizon.
+func SoundHorizon() float64 { return DefaultCosmology().SoundHorizon() }
+
+// SoundHorizonChecked is DefaultCosmology().SoundHorizonChecked.
+func SoundHorizonChecked() (float64, error) { return DefaultCosmology().SoundHorizonChecked() }
+
+// RecessionVelocity is DefaultCosmology().RecessionVelocity.
+func RecessionVelocity(distanceMpc float64) float64 {
+	return DefaultCosmology().RecessionVelocity(distanceMpc)
+}
+
+// HubbleDistance is DefaultCosmology().HubbleDistance.
+func HubbleDistance(velocityKmS float64) float64 {
+	return DefaultCosmology().HubbleDistance(velocityKmS)
+}
+
+// HubbleTime is DefaultCosmology().HubbleTime.
+func HubbleTime() float64 { return DefaultCosmology().HubbleTime() }
@@ -0,0 +1,141 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRedshiftAgeRoundTrip(t *testing.T) {
+	for _, z := range []float64{0, 0.5, 2, 10, 1100} {
+		age, err := AgeFromRedshift(z)
+		if err != nil {
+			t.Fatalf("AgeFromRedshift(%g): %v", z, err)
+		}
+		if got := RedshiftFromAge(age); math.Abs(got-z) > 1e-6*max(1, z) {
+			t.Errorf("z = %g → %g Myr → z = %g", z, age, got)
+		}
+	}
+	if age, _ := AgeFromRedshift(0); math.Abs(age-PresentAge()) > 1e-6 {
+		t.Errorf("age at z = 0 is %g Myr, want %g", age, PresentAge())
+	}
+}
+
+func TestHubbleParameterMatchesExpansion(t *testing.T) {
+	if got := ScaleFactor(PresentAge()); math.Abs(got-1) > 1e-12 {
+		t.Errorf("scale factor at the present age %g Myr is %g, want 1", PresentAge(), got)
+	}
+	for _, age := range []float64{1, 1000, 5000, PresentAge(), 30000} {
+		// d ln a/dt by central difference, converted from Myr⁻¹ to km/s/Mpc.
+		dt := age * 1e-4
+		rate := (math.Log(ScaleFactor(age+dt)) - math.Log(ScaleFactor(age-dt))) / (2 * dt)
+		want := rate / secondsPerMyr * metersPerMpc / 1000
+		if got := HubbleParameter(RedshiftFromAge(age)); math.Abs(got/want-1) > 1e-6 {
+			t.Errorf("at %g Myr H(z) = %g km/s/Mpc, d ln a/dt = %g", age, got, want)
+		}
+	}
+}
+
+func TestRadiationFreeUniverses(t *testing.T) {
+	// Einstein–de Sitter: a = (t/t₀)^(2/3) with t₀ = 2/(3H0).
+	eds := Cosmology{H0: 70, OmegaMatter: 1}
+	t0 := 2 / (3 * eds.hubblePerMyr())
+	if got := eds.PresentAge(); math.Abs(got/t0-1) > 1e-9 {
+		t.Errorf("Einstein–de Sitter present age %g Myr, want %g", got, t0)
+	}
+	for _, age := range []float64{1e-3, 1, 1000, t0} {
+		if got, want := eds.ScaleFactor(age), math.Pow(age/t0, 2.0/3); math.Abs(got/want-1) > 1e-9 {
+			t.Errorf("Einstein–de Sitter a(%g Myr) = %g, want %g", age, got, want)
+		}
+	}
+	// Flat ΛCDM without radiation: t(a) = 2/(3H0√ΩΛ)·asinh(√(ΩΛ/Ωm)·a^(3/2)).
+	c := DefaultCosmology()
+	c.CMBTempToday = 0
+	want := 2 / (3 * c.hubblePerMyr() * math.Sqrt(c.OmegaLambda)) * math.Asinh(math.Sqrt(c.OmegaLambda/c.OmegaMatter))
+	if got := c.PresentAge(); math.Abs(got/want-1) > 1e-9 {
+		t.Errorf("radiation-free ΛCDM present age %g Myr, want %g", got, want)
+	}
+	if z := c.RedshiftFromAge(1000); math.IsNaN(z) || math.IsInf(z, 0) {
+		t.Errorf("radiation-free ΛCDM redshift at 1000 Myr is %g", z)
+	}
+}
+
+func TestClosedUniverseScaleFactor(t *testing.T) {
+	// Ωm = 2 with no dark energy turns around near a = 2.
+	c := Cosmology{H0: 70, OmegaMatter: 2, CMBTempToday: CMBTemperatureToday}
+	prev := 0.0
+	for _, age := range []float64{100, 1000, c.PresentAge(), 13800, 30000} {
+		a := c.ScaleFactor(age)
+		if !(a > prev) {
+			t.Fatalf("closed universe: a(%g Myr) = %g, want above %g", age, a, prev)
+		}
+		if got := c.cosmicTime(a); math.Abs(got/age-1) > 1e-9 {
+			t.Errorf("closed universe: a(%g Myr) = %g, which is reached at %g Myr", age, a, got)
+		}
+		prev = a
+	}
+	if a := c.ScaleFactor(1e6); math.IsNaN(a) || a < prev || a > 2 {
+		t.Errorf("closed universe past its turnaround: a = %g, want the maximum between %g and 2", a, prev)
+	}
+}
+
+func TestNeutralHydrogenFractionMonotonic(t *testing.T) {
+	for _, tc := range []struct {
+		z    float64
+		want float64
+	}{{0, 0}, {300, 1}, {3000, 0}} {
+		if got := NeutralHydrogenFraction(tc.z); math.Abs(got-tc.want) > 1e-3 {
+			t.Errorf("neutral fraction at z = %g is %g, want ≈%g", tc.z, got, tc.want)
+		}
+	}
+	// Going back in time the fraction rises through reionization to a single
+	// peak in the dark ages, then falls through recombination. The peak sits
+	// where the two transitions' tails cross, not at a fixed redshift. Steps
+	// within rounding of the plateau are ignored.
+	const slack = 1e-15
+	prev, peak := NeutralHydrogenFraction(0), -1.0
+	for z := 0.01; z <= 3000; z += 0.01 {
+		f := NeutralHydrogenFraction(z)
+		switch {
+		case peak < 0 && f < prev-slack:
+			peak = z
+		case peak >= 0 && f > prev+slack:
+			t.Fatalf("neutral fraction rises again at z = %g after peaking at z = %g", z, peak)
+		}
+		prev = f
+	}
+	if peak < 8 || peak > 1000 {
+		t.Errorf("neutral fraction peaks at z = %g, want in the dark ages", peak)
+	}
+}
+
+func TestNeutrinoTemperatureToday(t *testing.T) {
+	if got := NeutrinoTemperature(0); math.Abs(got-1.95) > 0.01 {
+		t.Errorf("relic neutrino temperature today %g K, want ≈1.95", got)
+	}
+	if got, want := NeutrinoTemperature(1100)/CMBTemperature(1100), math.Cbrt(4.0/11); math.Abs(got-want) > 1e-15 {
+		t.Errorf("T_ν/T_γ = %g, want (4/11)^(1/3) = %g", got, want)
+	}
+	if got := NeutrinoDecouplingTemperature(); math.Abs(got/1.16e10-1) > 0.01 {
+		t.Errorf("neutrino decoupling at %g K, want ≈1.16e10 (1 MeV)", got)
+	}
+}
+
+func TestLookbackTimeMonotonic(t *testing.T) {
+	if got := LookbackTime(0); got != 0 {
+		t.Errorf("lookback time at z = 0 is %g Myr", got)
+	}
+	prev := 0.0
+	for _, z := range []float64{1e-6, 0.1, 0.5, 1, 2, 10, 100, 1100, 1e6, math.Inf(1)} {
+		got := LookbackTime(z)
+		if got <= prev && z < 1e6 {
+			t.Errorf("lookback time %g Myr at z = %g does not exceed %g Myr", got, z, prev)
+		}
+		if got < prev || got > PresentAge() {
+			t.Errorf("lookback time %g Myr at z = %g outside [%g, %g]", got, z, prev, PresentAge())
+		}
+		prev = got
+	}
+	if got := LookbackTime(1100); PresentAge()-got > 1 {
+		t.Errorf("lookback time to recombination %g Myr, want within 1 Myr of the present age", got)
+	}
+}
@@ -0,0 +1,309 @@
+package universe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Engine advances a single timeline through cosmic time.
+//
+// Each Engine owns its own clock, so several timelines can run side by side
+// in one process. The package-level CosmicAgeMyr is left alone for code that
+// still reads it; an Engine never writes to it.
+//
+// Every random roll an Engine makes comes from its own source seeded with
+// its seed, so two engines built from the same seed and stepped the same way
+// live through identical timelines.
+type Engine struct {
+	cosmo  Cosmology
+	commit int64   // commits since the Big Bang, starting at BigBangCommit
+	age    float64 // Myr since the Big Bang
+	src    *countingSource
+	rng    *rand.Rand
+
+	stellarMass float64 // solar masses of stars formed by the present day
+
+	epochs    []Event // cosmic epochs, in order
+	nextEpoch int     // index of the first epoch not yet reached
+	events    []Event
+	onEvent   func(Event)
+}
+
+// NewEngine returns an Engine sitting at the Big Bang of DefaultCosmology.
+// A zero seed selects UniverseSeed, the seed of this repository's own
+// timeline.
+func NewEngine(seed int64) *Engine {
+	return NewEngineWithCosmology(seed, DefaultCosmology())
+}
+
+// NewEngineWithCosmology is NewEngine for an alternate universe governed by
+// c rather than DefaultCosmology.
+func NewEngineWithCosmology(seed int64, c Cosmology) *Engine {
+	if seed == 0 {
+		seed = UniverseSeed
+	}
+	e := &Engine{
+		cosmo:  c,
+		commit: BigBangCommit,
+		epochs: cosmicEpochs(c),
+	}
+	e.reseed(seed)
+	return e
+}
+
+// reseed starts the random source afresh from seed.
+func (e *Engine) reseed(seed int64) {
+	e.src = newCountingSource(seed)
+	e.rng = rand.New(e.src)
+}
+
+// SetStellarMass gives the engine's timeline a galaxy that forms
+// massSolar solar masses of stars by the present day. Its supernovae are
+// then drawn from the engine's random source as it steps and emitted as
+// EventSupernova; a step that sets off many emits them as one event with
+// their Count. The default of 0 leaves the timeline without a galaxy and
+// draws nothing.
+func (e *Engine) SetStellarMass(massSolar float64) {
+	e.stellarMass = max(massSolar, 0)
+}
+
+// StellarMass returns the stellar mass set by SetStellarMass.
+func (e *Engine) StellarMass() float64 {
+	return e.stellarMass
+}
+
+// Step advances the engine by dtMyr million years and returns the new age.
+// Each step counts as one commit. Every epoch the step carries the clock
+// past is emitted once, in order, however large the step, interleaved in
+// time with any supernovae that go off during it.
+func (e *Engine) Step(dtMyr float64) float64 {
+	from := e.age
+	e.age += dtMyr
+	e.commit++
+	for _, sn := range e.supernovae(from, e.age) {
+		e.emitEpochs(sn.AgeMyr)
+		e.emit(sn)
+	}
+	e.emitEpochs(e.age)
+	return e.age
+}
+
+// emitEpochs emits every epoch not yet reached that falls at or before
+// ageMyr.
+func (e *Engine) emitEpochs(ageMyr float64) {
+	for e.nextEpoch < len(e.epochs) && ageMyr >= e.epochs[e.nextEpoch].AgeMyr {
+		e.emit(e.epochs[e.nextEpoch])
+		e.nextEpoch++
+	}
+}
+
+// ErrStepTooSmall is returned by RunUntil, StepAdaptive and
+// WriteTimelineCSV when their step is too small to move the clock at the
+// current age.
+var ErrStepTooSmall = errors.New("universe: step too small to advance the clock")
+
+// RunUntil steps the engine by dtMyr until it reaches targetAgeMyr, with a
+// shorter final step so it lands on the target exactly. It checks ctx
+// before every step and returns ctx.Err() as soon as the context is done,
+// leaving the engine at its last completed step; the engine stays valid and
+// can be saved or run further. It fails with ErrStepTooSmall, rather than
+// spinning forever, once dtMyr falls below the float64 resolution of the
+// age.
+func (e *Engine) RunUntil(ctx context.Context, targetAgeMyr, dtMyr float64) error {
+	if dtMyr <= 0 {
+		return fmt.Errorf("run step of %g Myr: %w", dtMyr, ErrNonPositive)
+	}
+	for e.age < targetAgeMyr {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		dt := min(dtMyr, targetAgeMyr-e.age)
+		if e.age+dt == e.age {
+			return fmt.Errorf("run step of %g Myr at %g Myr: %w", dt, e.age, ErrStepTooSmall)
+		}
+		e.Step(dt)
+	}
+	return nil
+}
+
+// firstAdaptiveStepMyr is the step StepAdaptive takes off the Big Bang in a
+// cosmology without inflation, where a = 0 gives no rate to scale by.
+const firstAdaptiveStepMyr = 1e-45
+
+// maxAdaptiveHalvings bounds how often StepAdaptive shrinks a step that
+// overshoots its tolerance.
+const maxAdaptiveHalvings = 64
+
+// StepAdaptive advances the engine by a step of its own choosing, sized so
+// the scale factor grows by no more than the fraction tolerance, and
+// returns the step in Myr. The step is ln(1+tolerance)/H at the current
+// scale factor, so it is tiny while the early universe expands quickly and
+// long near the present; a step that still overshoots is halved until it
+// fits. Before inflation the scale factor is frozen, and the first step
+// jumps to the start of inflation; steps are clipped to land on the end of
+// inflation. A tolerance that is not positive and finite is rejected, and
+// like RunUntil it fails with ErrStepTooSmall rather than take a step that
+// would leave the clock where it is; either way the engine does not move.
+func (e *Engine) StepAdaptive(tolerance float64) (float64, error) {
+	if !(tolerance > 0) {
+		return 0, fmt.Errorf("adaptive step tolerance %g: %w", tolerance, ErrNonPositive)
+	}
+	if math.IsInf(tolerance, 1) {
+		return 0, fmt.Errorf("adaptive step tolerance %g: %w", tolerance, ErrNonFinite)
+	}
+	infl := e.cosmo.Inflation
+	var dt float64
+	switch {
+	case e.age < infl.StartAgeMyr:
+		dt = infl.StartAgeMyr - e.age
+	case e.age < infl.EndAgeMyr:
+		dt = min(math.Log1p(tolerance)/infl.Rate(), infl.EndAgeMyr-e.age)
+	case e.age <= 0:
+		dt = firstAdaptiveStepMyr
+	default:
+		dt = e.adaptiveStep(tolerance)
+	}
+	if e.age+dt == e.age {
+		return 0, fmt.Errorf("adaptive step of %g Myr at %g Myr: %w", dt, e.age, ErrStepTooSmall)
+	}
+	e.Step(dt)
+	return dt, nil
+}
+
+// adaptiveStep sizes a post-inflation step for StepAdaptive.
+func (e *Engine) adaptiveStep(tolerance float64) float64 {
+	a := e.ScaleFactor()
+	h := e.cosmo.hubblePerMyr() * math.Sqrt(e.cosmo.expansionRate2(a))
+	dt := math.Log1p(tolerance) / math.Abs(h)
+	if math.IsNaN(dt) || math.IsInf(dt, 0) {
+		dt = e.cosmo.PresentAge()
+	}
+	for range maxAdaptiveHalvings {
+		next := e.cosmo.ScaleFactorDuringInflation(e.age + dt)
+		if math.Abs(next/a-1) <= tolerance {
+			break
+		}
+		dt /= 2
+	}
+	return dt
+}
+
+func (e *Engine) emit(ev Event) {
+	e.events = append(e.events, ev)
+	if e.onEvent != nil {
+		e.onEvent(ev)
+	}
+}
+
+// Events returns the events emitted so far, oldest first.
+func (e *Engine) Events() []Event {
+	return append([]Event(nil), e.events...)
+}
+
+// OnEvent registers fn to be called with each event as it is emitted,
+// replacing any earlier callback. Register it before stepping; events
+// already emitted are not replayed.
+func (e *Engine) OnEvent(fn func(Event)) {
+	e.onEvent = fn
+}
+
+// Age returns the engine's cosmic age in Myr.
+func (e *Engine) Age() float64 {
+	return e.age
+}
+
+// ScaleFactor returns the scale factor at the engine's current age,
+// following inflation into the Friedmann expansion.
+func (e *Engine) ScaleFactor() float64 {
+	return e.cosmo.ScaleFactorDuringInflation(e.age)
+}
+
+// Cosmology returns the cosmology the engine's timeline runs under.
+func (e *Engine) Cosmology() Cosmology {
+	return e.cosmo
+}
+
+// Seed returns the seed of the engine's random source: the one the
+// timeline was created with, or the last one passed to Rand().Seed.
+func (e *Engine) Seed() int64 {
+	return e.src.seed
+}
+
+// Commit returns the number of commits since the Big Bang, counting the
+// Big Bang itself as BigBangCommit.
+func (e *Engine) Commit() int64 {
+	return e.commit
+}
+
+// Rand returns the engine's random source. Drawing from it advances the
+// timeline, so replays must make the same draws in the same order. The
+// buffered state behind Rand.Read is not part of a saved engine. Reseeding
+// it with Rand().Seed forks the timeline there: Seed reports the new seed,
+// and a saved engine replays from it.
+func (e *Engine) Rand() *rand.Rand {
+	return e.rng
+}
+
+// engineState is the saved form of an Engine. The random source is stored
+// as its seed and the number of values drawn so far. The event callback is
+// not saved; register it again after loading.
+type engineState struct {
+	Cosmology   *Cosmology `json:"cosmology"`
+	Seed        int64      `json:"seed"`
+	Commit      int64      `json:"commit_count"`
+	AgeMyr      float64    `json:"cosmic_age_million_years"`
+	StellarMass float64    `json:"stellar_mass_solar,omitempty"`
+	RandDraws   uint64     `json:"rand_draws"`
+	Events      []Event    `json:"events"`
+}
+
+// MarshalJSON saves the engine's full state, including the position of its
+// random source, so a checkpointed run can resume exactly where it stopped.
+func (e *Engine) MarshalJSON() ([]byte, error) {
+	return json.Marshal(engineState{
+		Cosmology:   &e.cosmo,
+		Seed:        e.src.seed,
+		Commit:      e.commit,
+		AgeMyr:      e.age,
+		StellarMass: e.stellarMass,
+		RandDraws:   e.src.draws,
+		Events:      e.events,
+	})
+}
+
+// UnmarshalJSON restores an engine saved by MarshalJSON. The random source
+// is replayed to its saved position, so the restored engine continues along
+// the same timeline as the original. A save without a cosmology runs under
+// DefaultCosmology, and one written before a cosmological parameter existed
+// takes that parameter from DefaultCosmology. A save without a seed follows
+// UniverseSeed, as NewEngine does.
+func (e *Engine) UnmarshalJSON(data []byte) error {
+	c := DefaultCosmology()
+	st := engineState{Cosmology: &c}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return err
+	}
+	e.cosmo = DefaultCosmology()
+	if st.Cosmology != nil {
+		e.cosmo = *st.Cosmology
+	}
+	if st.Seed == 0 {
+		st.Seed = UniverseSeed
+	}
+	e.commit = st.Commit
+	e.age = st.AgeMyr
+	e.stellarMass = st.StellarMass
+	e.events = st.Events
+	e.epochs = cosmicEpochs(e.cosmo)
+	e.nextEpoch = 0
+	for e.nextEpoch < len(e.epochs) && e.age >= e.epochs[e.nextEpoch].AgeMyr {
+		e.nextEpoch++
+	}
+	e.reseed(st.Seed)
+	e.src.skip(st.RandDraws)
+	return nil
+}
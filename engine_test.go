@@ -0,0 +1,227 @@
+package universe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalOldSaveUsesDefaults(t *testing.T) {
+	// A save from before reionization and inflation were configurable,
+	// and before seeds were written.
+	old := `{"cosmology":{"h0":70,"omega_lambda":0.68,"omega_matter":0.32,"omega_baryon":0.05,"cmb_temp_today":2.725},` +
+		`"commit_count":11,"cosmic_age_million_years":500,"rand_draws":0,"events":[]}`
+	var e Engine
+	if err := json.Unmarshal([]byte(old), &e); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.Cosmology(), DefaultCosmology(); got != want {
+		t.Errorf("restored cosmology %+v, want %+v", got, want)
+	}
+	if got := e.Seed(); got != UniverseSeed {
+		t.Errorf("restored seed %d, want UniverseSeed %d", got, UniverseSeed)
+	}
+	fresh := NewEngine(0)
+	fresh.Step(500)
+	if got, want := e.nextEpoch, fresh.nextEpoch; got != want {
+		t.Errorf("restored engine has %d epochs behind it, a fresh one %d", got, want)
+	}
+}
+
+// runTimeline steps e in a fixed pattern that exercises epochs, supernovae
+// and direct draws from Rand.
+func runTimeline(e *Engine, steps int) {
+	for i := range steps {
+		e.Step(float64(i%7+1) * 10)
+		e.Rand().Float64()
+	}
+}
+
+func TestSaveMidRunResumesIdentically(t *testing.T) {
+	original := NewEngine(99)
+	original.SetStellarMass(1e5)
+	runTimeline(original, 100)
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Engine
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	runTimeline(original, 200)
+	runTimeline(&restored, 200)
+	a, _ := json.Marshal(original)
+	b, _ := json.Marshal(&restored)
+	if string(a) != string(b) {
+		t.Error("restored engine diverged from the original")
+	}
+}
+
+func TestSaveWithoutRadiation(t *testing.T) {
+	c := DefaultCosmology()
+	c.CMBTempToday = 0
+	original := NewEngineWithCosmology(3, c)
+	original.Step(1000)
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Engine
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	if got := restored.Cosmology(); got != c {
+		t.Errorf("restored cosmology %+v, want %+v", got, c)
+	}
+	original.Step(20000)
+	restored.Step(20000)
+	a, _ := json.Marshal(original)
+	b, _ := json.Marshal(&restored)
+	if string(a) != string(b) {
+		t.Error("restored engine diverged from the original")
+	}
+}
+
+func TestSaveAfterReseedReplaysNewSeed(t *testing.T) {
+	e := NewEngine(5)
+	e.Rand().Int63()
+	e.Rand().Seed(77)
+	e.Rand().Int63()
+	if got := e.Seed(); got != 77 {
+		t.Errorf("Seed() = %d after Rand().Seed(77)", got)
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Engine
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	if a, b := e.Rand().Int63(), restored.Rand().Int63(); a != b {
+		t.Errorf("restored engine drew %d, the original %d", b, a)
+	}
+}
+
+func TestSameSeedSameTimeline(t *testing.T) {
+	run := func() []byte {
+		e := NewEngine(2024)
+		e.SetStellarMass(1e5)
+		runTimeline(e, 300)
+		data, err := json.Marshal(e.Events())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	if a, b := run(), run(); string(a) != string(b) {
+		t.Error("two engines with the same seed emitted different events")
+	}
+}
+
+func TestDifferentSeedsFork(t *testing.T) {
+	a, b := NewEngine(1), NewEngine(2)
+	if a.Rand().Int63() == b.Rand().Int63() {
+		t.Error("different seeds gave the same first draw")
+	}
+}
+
+func TestRunUntilStopsAtDeadline(t *testing.T) {
+	e := NewEngine(3)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := e.RunUntil(ctx, PresentAge(), 1e-6)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("RunUntil returned %v after the deadline", elapsed-50*time.Millisecond)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RunUntil returned %v, want context.DeadlineExceeded", err)
+	}
+	progress := e.Age()
+	if progress <= 0 || progress >= PresentAge() {
+		t.Fatalf("Age() = %g after the deadline, want partial progress", progress)
+	}
+	if err := e.RunUntil(context.Background(), progress+1, 0.5); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Age(); got != progress+1 {
+		t.Errorf("resumed to %g Myr, want %g", got, progress+1)
+	}
+}
+
+func TestStepAdaptiveMatchesFixedSteps(t *testing.T) {
+	const tol = 1e-3
+	e := NewEngine(1)
+	e.Step(1000)
+	a0 := e.ScaleFactor()
+	var elapsed, lastDt float64
+	for e.ScaleFactor() < 2*a0 {
+		a := e.ScaleFactor()
+		dt, err := e.StepAdaptive(tol)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lastDt = dt
+		elapsed += dt
+		if growth := e.ScaleFactor()/a - 1; growth > tol*(1+1e-9) {
+			t.Fatalf("step at %g Myr grew a by %g, over the tolerance %g", e.Age()-lastDt, growth, tol)
+		}
+	}
+	if math.Abs(1000+elapsed-e.Age()) > 1e-9 {
+		t.Errorf("returned steps sum to %g Myr, the clock moved %g", elapsed, e.Age()-1000)
+	}
+
+	// A fine fixed-step run to the same doubling of the scale factor.
+	const fine = 0.5
+	ref := NewEngine(1)
+	ref.Step(1000)
+	for ref.ScaleFactor() < 2*a0 {
+		ref.Step(fine)
+	}
+	if d := math.Abs(e.Age() - ref.Age()); d > lastDt+fine {
+		t.Errorf("adaptive steps doubled a at %g Myr, fixed steps at %g Myr", e.Age(), ref.Age())
+	}
+}
+
+func TestStepAdaptiveThroughInflation(t *testing.T) {
+	e := NewEngine(1)
+	infl := e.Cosmology().Inflation
+	if dt, err := e.StepAdaptive(0.01); err != nil || dt != infl.StartAgeMyr {
+		t.Errorf("first step %g Myr (%v), want a jump to the start of inflation at %g", dt, err, infl.StartAgeMyr)
+	}
+	for e.Age() < infl.EndAgeMyr {
+		if _, err := e.StepAdaptive(0.01); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e.Age() != infl.EndAgeMyr {
+		t.Errorf("inflation steps ended at %g Myr, want exactly %g", e.Age(), infl.EndAgeMyr)
+	}
+}
+
+func TestStepAdaptiveRejectsBadTolerances(t *testing.T) {
+	e := NewEngine(1)
+	e.Step(1000)
+	for _, tc := range []struct {
+		tolerance float64
+		want      error
+	}{
+		{0, ErrNonPositive},
+		{-0.1, ErrNonPositive},
+		{math.NaN(), ErrNonPositive},
+		{math.Inf(1), ErrNonFinite},
+		{1e-300, ErrStepTooSmall},
+	} {
+		if dt, err := e.StepAdaptive(tc.tolerance); !errors.Is(err, tc.want) || dt != 0 {
+			t.Errorf("StepAdaptive(%g) = %g, %v; want 0, %v", tc.tolerance, dt, err, tc.want)
+		}
+	}
+	if got := e.Age(); got != 1000 {
+		t.Errorf("rejected steps moved the engine to %g Myr", got)
+	}
+}
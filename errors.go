@@ -0,0 +1,15 @@
+package universe
+
+import "errors"
+
+// Errors returned by the Checked variants when an input is outside the
+// physical domain, or when a numerical result cannot be trusted. They are
+// wrapped with the offending value, so compare with errors.Is.
+var (
+	ErrNegativeMass  = errors.New("universe: mass is negative")
+	ErrNonPositive   = errors.New("universe: value must be positive")
+	ErrNonFinite     = errors.New("universe: value must be finite")
+	ErrSuperluminal  = errors.New("universe: speed is at or above the speed of light")
+	ErrInsideHorizon = errors.New("universe: radius is at or inside the event horizon")
+	ErrNoConvergence = errors.New("universe: integral did not converge")
+)
@@ -0,0 +1,79 @@
+package universe
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// Event is a named moment in a timeline, emitted by an Engine as its clock
+// passes it.
+type Event struct {
+	Name     string  `json:"name"`
+	AgeMyr   float64 `json:"age_myr"`
+	Redshift float64 `json:"redshift"`
+
+	// Count is the number of supernovae a supernova event stands for; it is
+	// 0 for the cosmic epochs.
+	Count int `json:"count,omitempty"`
+}
+
+// Names of the cosmic epochs an Engine announces, in the order they occur.
+const (
+	EventInflationEnd            = "inflation_end"
+	EventMatterRadiationEquality = "matter_radiation_equality"
+	EventRecombination           = "recombination"
+	EventReionization            = "reionization"
+	EventPresentDay              = "present_day"
+)
+
+// EventSupernova names core-collapse supernovae in the engine's galaxy:
+// one, or in a busy step Count of them. See (*Engine).SetStellarMass.
+const EventSupernova = "supernova"
+
+// cosmicEpochs returns the epochs of a timeline under c in chronological
+// order. Their order follows from c: in a matter-poor universe matter and
+// radiation come to equality after recombination. An epoch that c gives no
+// finite age and redshift is left out, so it never reaches a saved engine:
+// inflation_end without inflation, whose redshift is infinite at the Big
+// Bang; matter-radiation equality in a universe without radiation; and any
+// epoch whose redshift lies in the future.
+func cosmicEpochs(c Cosmology) []Event {
+	var epochs []Event
+	if inflationEnd := c.Inflation.EndAgeMyr; inflationEnd > 0 && c.Inflation.Rate() > 0 {
+		epochs = append(epochs, Event{Name: EventInflationEnd, AgeMyr: inflationEnd, Redshift: c.RedshiftFromAge(inflationEnd)})
+	}
+	for _, ep := range []struct {
+		name string
+		z    float64
+	}{
+		{EventMatterRadiationEquality, c.MatterRadiationEquality()},
+		{EventRecombination, recombinationRedshift},
+		{EventReionization, c.ReionizationRedshift},
+	} {
+		if ev, ok := epochAtRedshift(c, ep.name, ep.z); ok {
+			epochs = append(epochs, ev)
+		}
+	}
+	epochs = append(epochs, Event{Name: EventPresentDay, AgeMyr: c.PresentAge(), Redshift: 0})
+	epochs = slices.DeleteFunc(epochs, func(ev Event) bool {
+		return !finite(ev.AgeMyr) || !finite(ev.Redshift)
+	})
+	sort.SliceStable(epochs, func(i, j int) bool { return epochs[i].AgeMyr < epochs[j].AgeMyr })
+	return epochs
+}
+
+// epochAtRedshift builds the epoch observed at z. It reports false if z
+// gives no age, such as a future or unphysical redshift.
+func epochAtRedshift(c Cosmology, name string, z float64) (Event, bool) {
+	age, err := c.AgeFromRedshift(z)
+	if err != nil {
+		return Event{}, false
+	}
+	return Event{Name: name, AgeMyr: age, Redshift: z}, true
+}
+
+// finite reports whether v is neither NaN nor infinite.
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
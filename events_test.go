@@ -0,0 +1,67 @@
+package universe
+
+import "testing"
+
+func TestCosmicEpochsInOrder(t *testing.T) {
+	matterPoor := DefaultCosmology()
+	matterPoor.OmegaMatter = 0.03
+	matterPoor.OmegaBaryon = 0.005
+	lateReionization := DefaultCosmology()
+	lateReionization.ReionizationRedshift = 2000
+	for name, c := range map[string]Cosmology{
+		"default":           DefaultCosmology(),
+		"matter-poor":       matterPoor,
+		"late reionization": lateReionization,
+	} {
+		epochs := cosmicEpochs(c)
+		for i := 1; i < len(epochs); i++ {
+			if epochs[i].AgeMyr < epochs[i-1].AgeMyr {
+				t.Errorf("%s: %s at %g Myr listed after %s at %g Myr", name,
+					epochs[i].Name, epochs[i].AgeMyr, epochs[i-1].Name, epochs[i-1].AgeMyr)
+			}
+		}
+	}
+}
+
+func TestRecombinationBeforeLateEquality(t *testing.T) {
+	c := DefaultCosmology()
+	c.OmegaMatter = 0.03
+	c.OmegaBaryon = 0.005
+	if z := c.MatterRadiationEquality(); z > recombinationRedshift {
+		t.Fatalf("equality at z = %g, want it after recombination", z)
+	}
+	e := NewEngineWithCosmology(1, c)
+	var fired []string
+	e.OnEvent(func(ev Event) { fired = append(fired, ev.Name) })
+	recombination, _ := c.AgeFromRedshift(recombinationRedshift)
+	e.Step(recombination * 1.01)
+	for _, name := range fired {
+		if name == EventRecombination {
+			return
+		}
+	}
+	t.Errorf("recombination not emitted by %g Myr; fired %v", recombination*1.01, fired)
+}
+
+func TestCosmicEpochsDropUnreachable(t *testing.T) {
+	c := DefaultCosmology()
+	c.ReionizationRedshift = -0.5
+	for _, ev := range cosmicEpochs(c) {
+		if ev.Name == EventReionization {
+			t.Errorf("reionization in the future still listed at %g Myr", ev.AgeMyr)
+		}
+	}
+}
+
+func TestCosmicEpochsFiniteWithoutRadiation(t *testing.T) {
+	c := DefaultCosmology()
+	c.CMBTempToday = 0
+	for _, ev := range cosmicEpochs(c) {
+		if ev.Name == EventMatterRadiationEquality {
+			t.Errorf("matter-radiation equality listed at %g Myr in a universe without radiation", ev.AgeMyr)
+		}
+		if !finite(ev.AgeMyr) || !finite(ev.Redshift) {
+			t.Errorf("%s at %g Myr, z = %g", ev.Name, ev.AgeMyr, ev.Redshift)
+		}
+	}
+}
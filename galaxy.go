@@ -0,0 +1,89 @@
+package universe
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Galaxy is a galaxy in a mock catalog: a comoving position in Mpc inside
+// the survey box and a total mass in kg.
+type Galaxy struct {
+	X, Y, Z float64
+	Mass    float64
+
+	cosmo *Cosmology // the universe it was seeded in; nil for DefaultCosmology
+}
+
+// Schechter mass function φ(M) ∝ (M/M*)^α e^(-M/M*). Galaxies are drawn
+// between schechterMinMass and schechterMaxMass, in units of M*.
+const (
+	schechterMass    = 1e11 * solarMass // M*
+	schechterAlpha   = -1.25
+	schechterMinMass = 1e-2
+	schechterMaxMass = 1e2
+)
+
+// virialOverdensity is the mean density of a virialized halo in units of
+// the critical density.
+const virialOverdensity = 200
+
+// SeedGalaxies scatters n galaxies uniformly through a cube boxSizeMpc on a
+// side, with masses drawn from a Schechter mass function. It draws from a
+// fresh NewCosmicRand, so every call returns the same catalog for this
+// universe's seed.
+func SeedGalaxies(n int, boxSizeMpc float64) []Galaxy {
+	return seedGalaxies(NewCosmicRand(), nil, n, boxSizeMpc)
+}
+
+// SeedGalaxies is like the package-level SeedGalaxies but draws from the
+// engine's own random source. It advances the timeline, so engines with the
+// same seed and history produce the same catalog. The galaxies belong to
+// the engine's cosmology, which sets their virial radii.
+func (e *Engine) SeedGalaxies(n int, boxSizeMpc float64) []Galaxy {
+	c := e.cosmo
+	return seedGalaxies(e.rng, &c, n, boxSizeMpc)
+}
+
+func seedGalaxies(r *rand.Rand, c *Cosmology, n int, boxSizeMpc float64) []Galaxy {
+	if n <= 0 {
+		return nil
+	}
+	galaxies := make([]Galaxy, n)
+	for i := range galaxies {
+		galaxies[i] = Galaxy{
+			X:     r.Float64() * boxSizeMpc,
+			Y:     r.Float64() * boxSizeMpc,
+			Z:     r.Float64() * boxSizeMpc,
+			Mass:  schechterMass * sampleSchechter(r),
+			cosmo: c,
+		}
+	}
+	return galaxies
+}
+
+// sampleSchechter draws M/M* from the Schechter function: a candidate from
+// the power law x^α by inverting its CDF, kept with probability e^(-x).
+func sampleSchechter(r *rand.Rand) float64 {
+	p := schechterAlpha + 1
+	lo, hi := math.Pow(schechterMinMass, p), math.Pow(schechterMaxMass, p)
+	for {
+		x := math.Pow(lo+r.Float64()*(hi-lo), 1/p)
+		if r.Float64() < math.Exp(-x) {
+			return x
+		}
+	}
+}
+
+// VirialRadius returns the radius in meters within which the galaxy's mean
+// density is 200 times the present critical density, the conventional edge
+// of its dark matter halo. The critical density is that of the cosmology
+// the galaxy was seeded in: the engine's for (*Engine).SeedGalaxies, and
+// DefaultCosmology otherwise.
+func (g Galaxy) VirialRadius() float64 {
+	c := DefaultCosmology()
+	if g.cosmo != nil {
+		c = *g.cosmo
+	}
+	rho := virialOverdensity * c.CriticalDensity()
+	return math.Cbrt(3 * g.Mass / (4 * math.Pi * rho))
+}
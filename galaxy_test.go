@@ -0,0 +1,47 @@
+package universe
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeedGalaxiesReproducible(t *testing.T) {
+	catalog := func(seed int64) []Galaxy {
+		e := NewEngine(seed)
+		e.Step(100)
+		return e.SeedGalaxies(500, 100)
+	}
+	a, b := catalog(11), catalog(11)
+	if !reflect.DeepEqual(a, b) {
+		t.Error("two engines with the same seed seeded different catalogs")
+	}
+	if reflect.DeepEqual(a, catalog(12)) {
+		t.Error("different seeds seeded the same catalog")
+	}
+	if !reflect.DeepEqual(SeedGalaxies(50, 10), SeedGalaxies(50, 10)) {
+		t.Error("SeedGalaxies differs between calls")
+	}
+	for _, g := range a {
+		if g.X < 0 || g.X >= 100 || g.Y < 0 || g.Y >= 100 || g.Z < 0 || g.Z >= 100 || g.Mass <= 0 {
+			t.Fatalf("galaxy %+v outside the box or massless", g)
+		}
+	}
+}
+
+func TestVirialRadiusFollowsEngineCosmology(t *testing.T) {
+	fast := DefaultCosmology()
+	fast.H0 *= 2
+	ref := NewEngine(5).SeedGalaxies(10, 100)
+	got := NewEngineWithCosmology(5, fast).SeedGalaxies(10, 100)
+	// Four times the critical density shrinks the radius by 4^(1/3).
+	for i := range got {
+		if ratio := got[i].VirialRadius() / ref[i].VirialRadius(); math.Abs(ratio*math.Cbrt(4)-1) > 1e-12 {
+			t.Errorf("galaxy %d: radius ratio %g with twice H0, want 4^(-1/3)", i, ratio)
+		}
+	}
+	g := Galaxy{Mass: ref[0].Mass}
+	if g.VirialRadius() != ref[0].VirialRadius() {
+		t.Error("a galaxy built by hand does not use DefaultCosmology")
+	}
+}
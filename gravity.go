@@ -0,0 +1,205 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// checkMassRadius validates the inputs shared by the surface quantities.
+func checkMassRadius(what string, massKg, radiusM float64) error {
+	if massKg < 0 {
+		return fmt.Errorf("%s of %g kg: %w", what, massKg, ErrNegativeMass)
+	}
+	if radiusM <= 0 {
+		return fmt.Errorf("%s at radius %g m: %w", what, radiusM, ErrNonPositive)
+	}
+	return nil
+}
+
+// EscapeVelocity returns the speed in m/s needed to escape from radiusM
+// above the center of massKg, √(2GM/r). Earth gives about 11.2 km/s.
+// Non-positive radius or negative mass gives 0.
+func EscapeVelocity(massKg, radiusM float64) float64 {
+	if massKg < 0 || radiusM <= 0 {
+		return 0
+	}
+	return math.Sqrt(2 * GravitationalConst * massKg / radiusM)
+}
+
+// EscapeVelocityChecked is EscapeVelocity but rejects non-positive radius
+// and negative mass.
+func EscapeVelocityChecked(massKg, radiusM float64) (float64, error) {
+	if err := checkMassRadius("escape velocity", massKg, radiusM); err != nil {
+		return 0, err
+	}
+	return EscapeVelocity(massKg, radiusM), nil
+}
+
+// EscapeVelocity is the typed form of EscapeVelocity: the speed in m/s
+// needed to escape m from radius r.
+func (m Kilograms) EscapeVelocity(r Meters) float64 {
+	return EscapeVelocity(float64(m), float64(r))
+}
+
+// SurfaceGravity returns the gravitational acceleration in m/s² at radiusM
+// from the center of massKg, GM/r². Non-positive radius or negative mass
+// gives 0.
+func SurfaceGravity(massKg, radiusM float64) float64 {
+	if massKg < 0 || radiusM <= 0 {
+		return 0
+	}
+	return GravitationalConst * massKg / (radiusM * radiusM)
+}
+
+// SurfaceGravityChecked is SurfaceGravity but rejects non-positive radius
+// and negative mass.
+func SurfaceGravityChecked(massKg, radiusM float64) (float64, error) {
+	if err := checkMassRadius("surface gravity", massKg, radiusM); err != nil {
+		return 0, err
+	}
+	return SurfaceGravity(massKg, radiusM), nil
+}
+
+// OrbitalPeriod returns the period in seconds of an orbit with the given
+// semi-major axis around centralMassKg, 2π√(a³/GM) by Kepler's third law.
+// Non-positive inputs give 0.
+func OrbitalPeriod(semiMajorAxisM, centralMassKg float64) float64 {
+	if semiMajorAxisM <= 0 || centralMassKg <= 0 {
+		return 0
+	}
+	a := semiMajorAxisM
+	return 2 * math.Pi * math.Sqrt(a*a*a/(GravitationalConst*centralMassKg))
+}
+
+// OrbitalPeriodChecked is OrbitalPeriod but rejects non-positive inputs.
+func OrbitalPeriodChecked(semiMajorAxisM, centralMassKg float64) (float64, error) {
+	if semiMajorAxisM <= 0 {
+		return 0, fmt.Errorf("orbital period at semi-major axis %g m: %w", semiMajorAxisM, ErrNonPositive)
+	}
+	if centralMassKg <= 0 {
+		return 0, fmt.Errorf("orbital period around %g kg: %w", centralMassKg, ErrNonPositive)
+	}
+	return OrbitalPeriod(semiMajorAxisM, centralMassKg), nil
+}
+
+// OrbitalPeriod is the typed form of OrbitalPeriod: the period of an orbit
+// of semi-major axis a around m.
+func (m Kilograms) OrbitalPeriod(a Meters) Seconds {
+	return Seconds(OrbitalPeriod(float64(a), float64(m)))
+}
+
+// SemiMajorAxis returns the semi-major axis in meters of an orbit with the
+// given period around centralMassKg, the inverse of OrbitalPeriod.
+// Non-positive inputs give 0.
+func SemiMajorAxis(periodS, centralMassKg float64) float64 {
+	if periodS <= 0 || centralMassKg <= 0 {
+		return 0
+	}
+	return math.Cbrt(GravitationalConst * centralMassKg * periodS * periodS / (4 * math.Pi * math.Pi))
+}
+
+// SemiMajorAxisChecked is SemiMajorAxis but rejects non-positive inputs.
+func SemiMajorAxisChecked(periodS, centralMassKg float64) (float64, error) {
+	if periodS <= 0 {
+		return 0, fmt.Errorf("semi-major axis for period %g s: %w", periodS, ErrNonPositive)
+	}
+	if centralMassKg <= 0 {
+		return 0, fmt.Errorf("semi-major axis around %g kg: %w", centralMassKg, ErrNonPositive)
+	}
+	return SemiMajorAxis(periodS, centralMassKg), nil
+}
+
+// SemiMajorAxis is the typed form of SemiMajorAxis: the semi-major axis of
+// an orbit of period t around m.
+func (m Kilograms) SemiMajorAxis(t Seconds) Meters {
+	return Meters(SemiMajorAxis(float64(t), float64(m)))
+}
+
+// checkJeans validates the gas properties shared by the Jeans scales.
+func checkJeans(what string, tempK, numberDensity, meanMolecularWeight float64) error {
+	switch {
+	case tempK <= 0:
+		return fmt.Errorf("%s at %g K: %w", what, tempK, ErrNonPositive)
+	case numberDensity <= 0:
+		return fmt.Errorf("%s at number density %g m⁻³: %w", what, numberDensity, ErrNonPositive)
+	case meanMolecularWeight <= 0:
+		return fmt.Errorf("%s at mean molecular weight %g: %w", what, meanMolecularWeight, ErrNonPositive)
+	}
+	return nil
+}
+
+// JeansLength returns the length scale in meters above which a uniform gas
+// cloud collapses under its own gravity, √(15kT/(4πGμm_Hρ)), for a cloud at
+// tempK with numberDensity particles per m³ of mean molecular weight μ.
+// Non-positive inputs give 0.
+func JeansLength(tempK, numberDensity, meanMolecularWeight float64) float64 {
+	if tempK <= 0 || numberDensity <= 0 || meanMolecularWeight <= 0 {
+		return 0
+	}
+	m := meanMolecularWeight * protonMass
+	rho := numberDensity * m
+	return math.Sqrt(15 * BoltzmannConstant * tempK / (4 * math.Pi * GravitationalConst * m * rho))
+}
+
+// JeansLengthChecked is JeansLength but rejects non-positive inputs.
+func JeansLengthChecked(tempK, numberDensity, meanMolecularWeight float64) (float64, error) {
+	if err := checkJeans("jeans length", tempK, numberDensity, meanMolecularWeight); err != nil {
+		return 0, err
+	}
+	return JeansLength(tempK, numberDensity, meanMolecularWeight), nil
+}
+
+// JeansMass returns the mass in kg above which a gas cloud collapses, the
+// mass of a sphere one Jeans length in radius. A cold molecular cloud core
+// (10 K, 10⁸ m⁻³, μ = 2.33) gives a few tens of solar masses; a warmer,
+// more diffuse clump (20 K, 10⁷ m⁻³) a few hundred. Non-positive inputs
+// give 0.
+func JeansMass(tempK, numberDensity, meanMolecularWeight float64) float64 {
+	r := JeansLength(tempK, numberDensity, meanMolecularWeight)
+	rho := numberDensity * meanMolecularWeight * protonMass
+	return 4.0 / 3.0 * math.Pi * r * r * r * rho
+}
+
+// JeansMassChecked is JeansMass but rejects non-positive inputs.
+func JeansMassChecked(tempK, numberDensity, meanMolecularWeight float64) (float64, error) {
+	if err := checkJeans("jeans mass", tempK, numberDensity, meanMolecularWeight); err != nil {
+		return 0, err
+	}
+	return JeansMass(tempK, numberDensity, meanMolecularWeight), nil
+}
+
+// RocheLimit returns the distance in meters from a primary of radius
+// primaryRadiusM inside which a rigid satellite is torn apart by tides,
+// R(2ρM/ρm)^(1/3) for primary density ρM and satellite density ρm in kg/m³.
+// Non-positive inputs give 0.
+func RocheLimit(primaryDensity, satelliteDensity, primaryRadiusM float64) float64 {
+	if primaryDensity <= 0 || satelliteDensity <= 0 || primaryRadiusM <= 0 {
+		return 0
+	}
+	return primaryRadiusM * math.Cbrt(2*primaryDensity/satelliteDensity)
+}
+
+// RocheLimitChecked is RocheLimit but rejects non-positive inputs, zero
+// satellite density in particular.
+func RocheLimitChecked(primaryDensity, satelliteDensity, primaryRadiusM float64) (float64, error) {
+	switch {
+	case primaryDensity <= 0:
+		return 0, fmt.Errorf("roche limit for primary density %g kg/m³: %w", primaryDensity, ErrNonPositive)
+	case satelliteDensity <= 0:
+		return 0, fmt.Errorf("roche limit for satellite density %g kg/m³: %w", satelliteDensity, ErrNonPositive)
+	case primaryRadiusM <= 0:
+		return 0, fmt.Errorf("roche limit for primary radius %g m: %w", primaryRadiusM, ErrNonPositive)
+	}
+	return RocheLimit(primaryDensity, satelliteDensity, primaryRadiusM), nil
+}
+
+// TidalForce returns the tidal stretching in N per kg of satellite across a
+// body of radius bodyRadiusM at distanceM from massKg, 2GMr/d³: the
+// difference in pull between its near side and its center. Non-positive
+// distance or negative mass or radius gives 0.
+func TidalForce(massKg, distanceM, bodyRadiusM float64) float64 {
+	if massKg < 0 || distanceM <= 0 || bodyRadiusM < 0 {
+		return 0
+	}
+	return 2 * GravitationalConst * massKg * bodyRadiusM / (distanceM * distanceM * distanceM)
+}
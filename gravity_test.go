@@ -0,0 +1,70 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOrbitalPeriodSemiMajorAxisRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		a, m   float64
+		period float64 // s, 0 to skip
+	}{
+		{"Earth around the Sun", metersPerAU, solarMass, secondsPerYear},
+		{"Moon around the Earth", 3.844e8, 5.972e24, 0},
+		{"star around Sgr A*", 1000 * metersPerAU, 4.3e6 * solarMass, 0},
+	} {
+		p := OrbitalPeriod(tc.a, tc.m)
+		if back := SemiMajorAxis(p, tc.m); math.Abs(back/tc.a-1) > 1e-9 {
+			t.Errorf("%s: a = %g m → %g s → a = %g m", tc.name, tc.a, p, back)
+		}
+		if tc.period > 0 && math.Abs(p/tc.period-1) > 1e-3 {
+			t.Errorf("%s: period %g s, want ≈%g", tc.name, p, tc.period)
+		}
+	}
+}
+
+func TestJeansMassMolecularClouds(t *testing.T) {
+	// "A few hundred solar masses" holds for a warm, diffuse clump; a cold
+	// dense core is an order of magnitude lighter, since M_J ∝ T^(3/2) n^(-1/2).
+	for _, tc := range []struct {
+		name      string
+		tempK, n  float64 // K, molecules per m³
+		wantSolar float64
+	}{
+		{"cold core", 10, 1e8, 53.8},
+		{"warm clump", 20, 1e7, 481},
+	} {
+		got := JeansMass(tc.tempK, tc.n, 2.33) / solarMass
+		if math.Abs(got/tc.wantSolar-1) > 0.01 {
+			t.Errorf("%s (%g K, %g m⁻³): Jeans mass %.3g M☉, want %.3g", tc.name, tc.tempK, tc.n, got, tc.wantSolar)
+		}
+	}
+	if JeansMass(0, 1e8, 2.33) != 0 {
+		t.Error("Jeans mass at 0 K is not 0")
+	}
+}
+
+func TestRocheLimitSaturn(t *testing.T) {
+	const (
+		saturnRadius  = 6.0268e7 // m, equatorial
+		saturnDensity = 687      // kg/m³
+		iceDensity    = 900      // kg/m³, a ring particle or small icy moon
+		aRingOuter    = 1.3678e8 // m, outer edge of the A ring
+		panOrbit      = 1.3358e8 // m, in the Encke gap
+		mimasOrbit    = 1.8552e8 // m
+	)
+	roche := RocheLimit(saturnDensity, iceDensity, saturnRadius)
+	if roche <= saturnRadius || roche >= aRingOuter {
+		t.Errorf("Roche limit %g m, want it above the cloud tops and inside the main rings", roche)
+	}
+	for name, orbit := range map[string]float64{"Pan": panOrbit, "Mimas": mimasOrbit} {
+		if orbit <= roche {
+			t.Errorf("%s orbits at %g m, inside the Roche limit %g m", name, orbit, roche)
+		}
+	}
+	if _, err := RocheLimitChecked(saturnDensity, 0, saturnRadius); err == nil {
+		t.Error("zero satellite density accepted")
+	}
+}
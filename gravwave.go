@@ -0,0 +1,55 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// ChirpMass returns the chirp mass in kg of a binary with component masses
+// m1Kg and m2Kg, (m1m2)^(3/5)/(m1+m2)^(1/5), the combination that sets the
+// gravitational wave signal. Non-positive masses give 0.
+func ChirpMass(m1Kg, m2Kg float64) float64 {
+	if m1Kg <= 0 || m2Kg <= 0 {
+		return 0
+	}
+	return math.Pow(m1Kg*m2Kg, 0.6) / math.Pow(m1Kg+m2Kg, 0.2)
+}
+
+// ChirpMassChecked is ChirpMass but rejects non-positive masses.
+func ChirpMassChecked(m1Kg, m2Kg float64) (float64, error) {
+	for _, m := range []float64{m1Kg, m2Kg} {
+		if m <= 0 {
+			return 0, fmt.Errorf("chirp mass with component %g kg: %w", m, ErrNonPositive)
+		}
+	}
+	return ChirpMass(m1Kg, m2Kg), nil
+}
+
+// GravitationalWaveStrain returns the dimensionless strain amplitude
+// (4/d)(GMc/c²)^(5/3)(πf/c)^(2/3) of a circular binary with chirp mass
+// chirpMassKg at distanceM, radiating at frequencyHz, twice the orbital
+// frequency. Two 30 M☉ black holes at 400 Mpc near 150 Hz give of order
+// 10⁻²¹, like GW150914. Non-positive inputs give 0.
+func GravitationalWaveStrain(chirpMassKg, distanceM, frequencyHz float64) float64 {
+	if chirpMassKg <= 0 || distanceM <= 0 || frequencyHz <= 0 {
+		return 0
+	}
+	c2 := SpeedOfLight * SpeedOfLight
+	return 4 / distanceM *
+		math.Pow(GravitationalConst*chirpMassKg/c2, 5.0/3.0) *
+		math.Pow(math.Pi*frequencyHz/SpeedOfLight, 2.0/3.0)
+}
+
+// GravitationalWaveStrainChecked is GravitationalWaveStrain but rejects
+// non-positive inputs.
+func GravitationalWaveStrainChecked(chirpMassKg, distanceM, frequencyHz float64) (float64, error) {
+	switch {
+	case chirpMassKg <= 0:
+		return 0, fmt.Errorf("strain for chirp mass %g kg: %w", chirpMassKg, ErrNonPositive)
+	case distanceM <= 0:
+		return 0, fmt.Errorf("strain at distance %g m: %w", distanceM, ErrNonPositive)
+	case frequencyHz <= 0:
+		return 0, fmt.Errorf("strain at frequency %g Hz: %w", frequencyHz, ErrNonPositive)
+	}
+	return GravitationalWaveStrain(chirpMassKg, distanceM, frequencyHz), nil
+}
@@ -0,0 +1,70 @@
+package universe
+
+import "math"
+
+// Inflation describes the burst of exponential expansion that precedes the
+// Friedmann regime: the scale factor grows by e^TotalEFolds between
+// StartAgeMyr and EndAgeMyr at a constant Hubble rate.
+type Inflation struct {
+	StartAgeMyr float64 `json:"start_age_myr"`
+	EndAgeMyr   float64 `json:"end_age_myr"`
+	TotalEFolds float64 `json:"total_e_folds"`
+}
+
+// DefaultInflation returns the standard picture: about 60 e-folds between
+// 10⁻³⁶ s and 10⁻³² s.
+func DefaultInflation() Inflation {
+	return Inflation{
+		StartAgeMyr: 1e-36 / secondsPerMyr,
+		EndAgeMyr:   1e-32 / secondsPerMyr,
+		TotalEFolds: 60,
+	}
+}
+
+// Rate returns the Hubble rate during inflation in Myr⁻¹, or 0 if the
+// window is empty.
+func (i Inflation) Rate() float64 {
+	if i.EndAgeMyr <= i.StartAgeMyr {
+		return 0
+	}
+	return i.TotalEFolds / (i.EndAgeMyr - i.StartAgeMyr)
+}
+
+// EFolds returns the number of e-folds of inflationary expansion between
+// startAgeMyr and endAgeMyr. Only the part of the interval inside the
+// inflation window counts.
+func (i Inflation) EFolds(startAgeMyr, endAgeMyr float64) float64 {
+	from := max(startAgeMyr, i.StartAgeMyr)
+	to := min(endAgeMyr, i.EndAgeMyr)
+	if to <= from {
+		return 0
+	}
+	return i.Rate() * (to - from)
+}
+
+// ScaleFactorDuringInflation returns the scale factor at ageMyr including
+// inflation. Inside the window it grows exponentially and meets the
+// Friedmann solution at the end of inflation, so a(t) is continuous through
+// the hand-off; after the window it is ScaleFactor. Before the window the
+// model holds a at its value when inflation starts.
+func (c Cosmology) ScaleFactorDuringInflation(ageMyr float64) float64 {
+	if ageMyr <= 0 {
+		return 0
+	}
+	i := c.Inflation
+	if ageMyr >= i.EndAgeMyr {
+		return c.ScaleFactor(ageMyr)
+	}
+	t := max(ageMyr, i.StartAgeMyr)
+	return c.ScaleFactor(i.EndAgeMyr) * math.Exp(-i.Rate()*(i.EndAgeMyr-t))
+}
+
+// EFolds is DefaultCosmology().Inflation.EFolds.
+func EFolds(startAgeMyr, endAgeMyr float64) float64 {
+	return DefaultCosmology().Inflation.EFolds(startAgeMyr, endAgeMyr)
+}
+
+// ScaleFactorDuringInflation is DefaultCosmology().ScaleFactorDuringInflation.
+func ScaleFactorDuringInflation(ageMyr float64) float64 {
+	return DefaultCosmology().ScaleFactorDuringInflation(ageMyr)
+}
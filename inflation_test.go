@@ -0,0 +1,44 @@
+package universe
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestInflationGrowsBySixtyEFolds(t *testing.T) {
+	c := DefaultCosmology()
+	i := c.Inflation
+	growth := c.ScaleFactorDuringInflation(i.EndAgeMyr) / c.ScaleFactorDuringInflation(i.StartAgeMyr)
+	if got := math.Log(growth); math.Abs(got-60) > 1e-9 {
+		t.Errorf("ln(a_end/a_start) = %g, want 60", got)
+	}
+	if got := EFolds(0, 1); math.Abs(got-60) > 1e-9 {
+		t.Errorf("EFolds over the whole window = %g, want 60", got)
+	}
+}
+
+func TestInflationHandsOffContinuously(t *testing.T) {
+	c := DefaultCosmology()
+	end := c.Inflation.EndAgeMyr
+	before := c.ScaleFactorDuringInflation(end * (1 - 1e-9))
+	after := c.ScaleFactorDuringInflation(end * (1 + 1e-9))
+	if math.Abs(after/before-1) > 1e-6 {
+		t.Errorf("a jumps from %g to %g across the end of inflation", before, after)
+	}
+}
+
+func TestEngineWithoutInflationSaves(t *testing.T) {
+	c := DefaultCosmology()
+	c.Inflation = Inflation{}
+	e := NewEngineWithCosmology(1, c)
+	e.Step(1)
+	for _, ev := range e.Events() {
+		if ev.Name == EventInflationEnd {
+			t.Errorf("%s emitted without an inflation window", ev.Name)
+		}
+	}
+	if _, err := json.Marshal(e); err != nil {
+		t.Fatalf("saving an engine without inflation: %v", err)
+	}
+}
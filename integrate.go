@@ -0,0 +1,63 @@
+package universe
+
+import "math"
+
+// maxSimpsonDepth bounds the recursion of integrate. Each level halves the
+// interval, so this is far finer than float64 can resolve.
+const maxSimpsonDepth = 50
+
+// maxIntegrandEvals is the step budget of integrate: the most times it
+// evaluates f in one call. Once it is spent, every remaining subinterval
+// keeps its current estimate and the error estimate grows accordingly. It
+// is a variable so tests can run it out.
+var maxIntegrandEvals = 1 << 20
+
+// integrate integrates f over [a, b] with adaptive Simpson's rule and
+// returns the result with an estimate of its absolute error. tol is
+// relative to the magnitude of the integral. The estimate is the sum over
+// the accepted subintervals of the Richardson correction |S₂ - S₁|/15, the
+// error of the uncorrected rule; the extrapolated result returned is usually
+// far better. An estimate above tol·|result| means the integral did not
+// converge within the depth limit or the step budget.
+func integrate(f func(float64) float64, a, b, tol float64) (result, errEstimate float64) {
+	s := simpson{f: f, evals: 3}
+	fa, fm, fb := f(a), f((a+b)/2), f(b)
+	whole := (b - a) / 6 * (fa + 4*fm + fb)
+	eps := tol * math.Abs(whole)
+	if eps == 0 {
+		eps = tol
+	}
+	return s.step(a, b, fa, fm, fb, whole, eps, maxSimpsonDepth)
+}
+
+// converged reports whether an integral from integrate met its tolerance.
+func converged(result, errEstimate, tol float64) bool {
+	return errEstimate <= tol*math.Abs(result) || errEstimate <= tol
+}
+
+// simpson carries the integrand and the spent step budget through the
+// recursion of integrate.
+type simpson struct {
+	f     func(float64) float64
+	evals int
+}
+
+func (s *simpson) step(a, b, fa, fm, fb, whole, eps float64, depth int) (result, errEstimate float64) {
+	m := (a + b) / 2
+	lm, rm := (a+m)/2, (m+b)/2
+	flm, frm := s.f(lm), s.f(rm)
+	s.evals += 2
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	delta := left + right - whole
+	// Stop once the correction is lost in rounding; halving eps further
+	// would only recurse on noise.
+	noise := 1e-15 * math.Abs(left+right)
+	if depth <= 0 || s.evals >= maxIntegrandEvals ||
+		math.Abs(delta) <= 15*eps || math.Abs(delta) <= noise {
+		return left + right + delta/15, math.Abs(delta) / 15
+	}
+	l, lerr := s.step(a, m, fa, flm, fm, left, eps/2, depth-1)
+	r, rerr := s.step(m, b, fm, frm, fb, right, eps/2, depth-1)
+	return l + r, lerr + rerr
+}
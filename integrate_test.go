@@ -0,0 +1,47 @@
+package universe
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntegrateSine(t *testing.T) {
+	for _, tol := range []float64{1e-3, 1e-6, 1e-10} {
+		got, errEst := integrate(math.Sin, 0, math.Pi, tol)
+		if trueErr := math.Abs(got - 2); trueErr > errEst {
+			t.Errorf("tol %g: true error %g exceeds the estimate %g", tol, trueErr, errEst)
+		}
+		if !converged(got, errEst, tol) {
+			t.Errorf("tol %g: estimate %g reported as not converged", tol, errEst)
+		}
+	}
+}
+
+func TestIntegrateEstimatesUnresolvedError(t *testing.T) {
+	f := func(x float64) float64 { return math.Sin(1 / x) }
+	got, errEst := integrate(f, 1e-9, 1, 1e-14)
+	if converged(got, errEst, 1e-14) {
+		t.Errorf("∫sin(1/x) to 1e-14 reported converged with estimate %g", errEst)
+	}
+}
+
+func TestDistancesReportExhaustedBudget(t *testing.T) {
+	defer func(n int) { maxIntegrandEvals = n }(maxIntegrandEvals)
+	maxIntegrandEvals = 16
+	if _, err := ComovingDistanceChecked(1); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("ComovingDistanceChecked on a spent budget: %v, want ErrNoConvergence", err)
+	}
+	if _, err := SoundHorizonChecked(); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("SoundHorizonChecked on a spent budget: %v, want ErrNoConvergence", err)
+	}
+}
+
+func TestDistancesConverge(t *testing.T) {
+	if _, err := ComovingDistanceChecked(1); err != nil {
+		t.Error(err)
+	}
+	if _, err := SoundHorizonChecked(); err != nil {
+		t.Error(err)
+	}
+}
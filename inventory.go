@@ -0,0 +1,78 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Inventory summarizes the contents of a timeline at one epoch. Densities
+// are in SI units: the critical density as mass per volume and the
+// components as energy per volume. In a flat cosmology the three energy
+// densities add up to the critical density times c²; otherwise the
+// difference is the curvature term.
+type Inventory struct {
+	AgeMyr          float64
+	Redshift        float64
+	ScaleFactor     float64
+	CMBTempK        float64
+	CriticalDensity float64 // kg/m³
+
+	MatterEnergyDensity    float64 // J/m³
+	RadiationEnergyDensity float64 // J/m³
+	DarkEnergyDensity      float64 // J/m³
+}
+
+// Inventory returns the inventory of the engine's timeline at its current
+// age, with each component evolved from today's density parameters: matter
+// dilutes as a⁻³, radiation as a⁻⁴ and dark energy stays constant. At the
+// Big Bang the critical density and the matter and radiation densities are
+// infinite.
+func (e *Engine) Inventory() Inventory {
+	c := e.cosmo
+	a := e.ScaleFactor()
+	z := 1/a - 1
+	rho0 := c.CriticalDensity() * SpeedOfLight * SpeedOfLight // J/m³ today
+	critical := math.Inf(1)
+	if a > 0 {
+		critical = c.CriticalDensity() * c.expansionRate2(a)
+	}
+	a2 := a * a
+	return Inventory{
+		AgeMyr:                 e.age,
+		Redshift:               z,
+		ScaleFactor:            a,
+		CMBTempK:               c.CMBTemperature(z),
+		CriticalDensity:        critical,
+		MatterEnergyDensity:    rho0 * c.OmegaMatter / (a2 * a),
+		RadiationEnergyDensity: rho0 * c.omegaRadiation() / (a2 * a2),
+		DarkEnergyDensity:      rho0 * c.omegaLambda(),
+	}
+}
+
+// String formats the inventory as a multi-line report, giving each
+// component's share of the critical energy density where it is finite.
+func (inv Inventory) String() string {
+	critical := inv.CriticalDensity * SpeedOfLight * SpeedOfLight
+	var b strings.Builder
+	fmt.Fprintf(&b, "Cosmic inventory at %.6g Myr\n", inv.AgeMyr)
+	fmt.Fprintf(&b, "  %-17s %.6g\n", "redshift", inv.Redshift)
+	fmt.Fprintf(&b, "  %-17s %.6g\n", "scale factor", inv.ScaleFactor)
+	fmt.Fprintf(&b, "  %-17s %.6g K\n", "CMB temperature", inv.CMBTempK)
+	fmt.Fprintf(&b, "  %-17s %.6g kg/m³\n", "critical density", inv.CriticalDensity)
+	for _, row := range []struct {
+		name    string
+		density float64
+	}{
+		{"matter", inv.MatterEnergyDensity},
+		{"radiation", inv.RadiationEnergyDensity},
+		{"dark energy", inv.DarkEnergyDensity},
+	} {
+		if math.IsInf(critical, 0) {
+			fmt.Fprintf(&b, "  %-17s %.6g J/m³\n", row.name, row.density)
+			continue
+		}
+		fmt.Fprintf(&b, "  %-17s %.6g J/m³ (%.4g%%)\n", row.name, row.density, 100*row.density/critical)
+	}
+	return b.String()
+}
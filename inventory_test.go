@@ -0,0 +1,52 @@
+package universe
+
+import (
+	"context"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// presentEngine returns an engine run to the present day.
+func presentEngine(t *testing.T) *Engine {
+	t.Helper()
+	e := NewEngine(0)
+	if err := e.RunUntil(context.Background(), PresentAge(), 100); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestInventoryGolden(t *testing.T) {
+	got := presentEngine(t).Inventory().String()
+	golden := filepath.Join("testdata", "inventory.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("Inventory().String() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestInventoryDensitiesSumToCritical(t *testing.T) {
+	e := NewEngine(0)
+	for _, age := range []float64{0.38, 100, 5000, PresentAge()} {
+		e.Step(age - e.Age())
+		inv := e.Inventory()
+		sum := inv.MatterEnergyDensity + inv.RadiationEnergyDensity + inv.DarkEnergyDensity
+		critical := inv.CriticalDensity * SpeedOfLight * SpeedOfLight
+		if math.Abs(sum/critical-1) > 1e-12 {
+			t.Errorf("at %g Myr: components sum to %g J/m³, critical is %g J/m³", age, sum, critical)
+		}
+	}
+}
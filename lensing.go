@@ -0,0 +1,53 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// arcsecPerRadian converts radians to arcseconds.
+const arcsecPerRadian = 180 * 3600 / math.Pi
+
+// LensDeflectionAngle returns the angle in radians through which a point
+// mass of massKg bends light passing it at impactParameterM, 4GM/(c²b).
+// Light grazing the Sun's limb is bent by about 1.75 arcseconds.
+// Non-positive impact parameter or negative mass gives 0.
+func LensDeflectionAngle(massKg, impactParameterM float64) float64 {
+	if massKg < 0 || impactParameterM <= 0 {
+		return 0
+	}
+	return 4 * GravitationalConst * massKg / (SpeedOfLight * SpeedOfLight * impactParameterM)
+}
+
+// LensDeflectionAngleChecked is LensDeflectionAngle but rejects
+// non-positive impact parameter and negative mass.
+func LensDeflectionAngleChecked(massKg, impactParameterM float64) (float64, error) {
+	if massKg < 0 {
+		return 0, fmt.Errorf("deflection by %g kg: %w", massKg, ErrNegativeMass)
+	}
+	if impactParameterM <= 0 {
+		return 0, fmt.Errorf("deflection at impact parameter %g m: %w", impactParameterM, ErrNonPositive)
+	}
+	return LensDeflectionAngle(massKg, impactParameterM), nil
+}
+
+// RadiansToArcsec converts an angle in radians to arcseconds.
+func RadiansToArcsec(rad float64) float64 {
+	return rad * arcsecPerRadian
+}
+
+// EinsteinRadius returns the angular radius in radians of the ring a point
+// mass of massKg at distLensMpc makes of a source directly behind it at
+// distSourceMpc, √(4GM/c² · D_LS/(D_L·D_S)). The lens-source distance is
+// taken as D_S - D_L, which holds for nearby lenses; at cosmological
+// distances pass angular diameter distances and treat the result as
+// approximate. Negative mass, a non-positive distance or a source no
+// farther than the lens gives 0.
+func EinsteinRadius(massKg, distLensMpc, distSourceMpc float64) float64 {
+	if massKg < 0 || distLensMpc <= 0 || distSourceMpc <= distLensMpc {
+		return 0
+	}
+	dl := distLensMpc * metersPerMpc
+	ds := distSourceMpc * metersPerMpc
+	return math.Sqrt(4 * GravitationalConst * massKg / (SpeedOfLight * SpeedOfLight) * (ds - dl) / (dl * ds))
+}
@@ -0,0 +1,38 @@
+package universe
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// RunMultiverse builds one Engine per seed, steps each by dtMyr until it
+// reaches untilAgeMyr, and returns them in seed order. The engines run
+// concurrently on a pool of runtime.NumCPU() workers. Each engine owns all
+// of its state, so the results do not depend on scheduling. A non-positive
+// dtMyr leaves every engine at the Big Bang, and one too small to advance
+// an engine's clock stops it where the clock stalls.
+func RunMultiverse(seeds []int64, untilAgeMyr, dtMyr float64) []*Engine {
+	engines := make([]*Engine, len(seeds))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				e := NewEngine(seeds[i])
+				// The only failures are a bad dtMyr, which leaves e where it
+				// stopped.
+				_ = e.RunUntil(context.Background(), untilAgeMyr, dtMyr)
+				engines[i] = e
+			}
+		}()
+	}
+	for i := range seeds {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return engines
+}
@@ -0,0 +1,48 @@
+package universe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestRunMultiverseIndependentOfScheduling(t *testing.T) {
+	seeds := []int64{1, 2, 3, 4, 5, 6, 7, 8, 42, 42}
+	want := make([]string, len(seeds))
+	for i, seed := range seeds {
+		e := NewEngine(seed)
+		if err := e.RunUntil(context.Background(), 1000, 50); err != nil {
+			t.Fatal(err)
+		}
+		data, _ := json.Marshal(e)
+		want[i] = string(data)
+	}
+	for _, procs := range []int{1, runtime.NumCPU()} {
+		prev := runtime.GOMAXPROCS(procs)
+		engines := RunMultiverse(seeds, 1000, 50)
+		runtime.GOMAXPROCS(prev)
+		for i, e := range engines {
+			if data, _ := json.Marshal(e); string(data) != want[i] {
+				t.Errorf("GOMAXPROCS=%d: seed %d differs from a serial run", procs, seeds[i])
+			}
+		}
+	}
+}
+
+func TestRunUntilRejectsStalledStep(t *testing.T) {
+	e := NewEngine(1)
+	e.Step(13000)
+	done := make(chan error, 1)
+	go func() { done <- e.RunUntil(context.Background(), 13800, 1e-13) }()
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrStepTooSmall) {
+			t.Errorf("RunUntil with a sub-ulp step returned %v, want ErrStepTooSmall", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("RunUntil with a sub-ulp step did not return")
+	}
+}
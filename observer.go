@@ -0,0 +1,63 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// SpacetimeEvent is a point in spacetime: a time in seconds and a position
+// in meters.
+type SpacetimeEvent struct {
+	T       float64
+	X, Y, Z float64
+}
+
+// Interval returns the invariant interval c²t² - x² - y² - z² in m², which
+// every inertial observer agrees on.
+func (ev SpacetimeEvent) Interval() float64 {
+	ct := SpeedOfLight * ev.T
+	return ct*ct - ev.X*ev.X - ev.Y*ev.Y - ev.Z*ev.Z
+}
+
+// Observer is an inertial frame moving at a constant velocity in m/s
+// relative to the cosmic rest frame, with origins that coincide at t = 0.
+type Observer struct {
+	VX, VY, VZ float64
+}
+
+// NewObserver returns an Observer moving at (vx, vy, vz) m/s, rejecting
+// speeds at or beyond c.
+func NewObserver(vx, vy, vz float64) (Observer, error) {
+	o := Observer{VX: vx, VY: vy, VZ: vz}
+	if s := o.Speed(); !subluminal(s) {
+		return Observer{}, fmt.Errorf("observer at %g m/s: %w", s, ErrSuperluminal)
+	}
+	return o, nil
+}
+
+// Speed returns the observer's speed in m/s.
+func (o Observer) Speed() float64 {
+	return math.Sqrt(o.VX*o.VX + o.VY*o.VY + o.VZ*o.VZ)
+}
+
+// Transform returns event, given in the cosmic rest frame, as seen by o,
+// applying the full Lorentz boost along o's velocity. The observer must be
+// slower than light; NewObserver enforces that.
+func (o Observer) Transform(event SpacetimeEvent) SpacetimeEvent {
+	bx, by, bz := o.VX/SpeedOfLight, o.VY/SpeedOfLight, o.VZ/SpeedOfLight
+	b2 := bx*bx + by*by + bz*bz
+	if b2 == 0 {
+		return event
+	}
+	gamma := 1 / math.Sqrt(1-b2)
+	ct := SpeedOfLight * event.T
+	bDotR := bx*event.X + by*event.Y + bz*event.Z
+	// The component of r along β is boosted; the transverse part is not.
+	k := (gamma-1)*bDotR/b2 - gamma*ct
+	return SpacetimeEvent{
+		T: gamma * (ct - bDotR) / SpeedOfLight,
+		X: event.X + k*bx,
+		Y: event.Y + k*by,
+		Z: event.Z + k*bz,
+	}
+}
@@ -0,0 +1,41 @@
+package universe
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTransformPreservesInterval(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := range 1000 {
+		// A random direction and a speed up to 0.99c.
+		dx, dy, dz := r.NormFloat64(), r.NormFloat64(), r.NormFloat64()
+		norm := math.Sqrt(dx*dx + dy*dy + dz*dz)
+		speed := 0.99 * SpeedOfLight * r.Float64()
+		o, err := NewObserver(speed*dx/norm, speed*dy/norm, speed*dz/norm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev := SpacetimeEvent{
+			T: r.NormFloat64(),
+			X: r.NormFloat64() * SpeedOfLight,
+			Y: r.NormFloat64() * SpeedOfLight,
+			Z: r.NormFloat64() * SpeedOfLight,
+		}
+		boosted := o.Transform(ev)
+		// Compare against the interval's terms, since it may nearly cancel.
+		ct := SpeedOfLight * ev.T
+		scale := ct*ct + ev.X*ev.X + ev.Y*ev.Y + ev.Z*ev.Z
+		if d := math.Abs(boosted.Interval() - ev.Interval()); d > 1e-12*LorentzFactor(speed)*LorentzFactor(speed)*scale {
+			t.Fatalf("case %d: interval %g became %g under a %gc boost", i, ev.Interval(), boosted.Interval(), speed/SpeedOfLight)
+		}
+	}
+}
+
+func TestNewObserverRejectsSuperluminal(t *testing.T) {
+	if _, err := NewObserver(0.8*SpeedOfLight, 0.8*SpeedOfLight, 0); !errors.Is(err, ErrSuperluminal) {
+		t.Errorf("observer at 1.13c: %v, want ErrSuperluminal", err)
+	}
+}
@@ -0,0 +1,86 @@
+package universe
+
+import "math"
+
+// Particle rest masses in kg (CODATA 2018). Neutrino masses are only bounded
+// from above; neutrinoMass uses a representative 0.1 eV/c².
+const (
+	electronMass = 9.1093837015e-31
+	protonMass   = 1.67262192369e-27
+	neutronMass  = 1.67492749804e-27
+	neutrinoMass = 0.1 * ElementaryCharge / (SpeedOfLight * SpeedOfLight)
+)
+
+// neutronLifetime is the mean lifetime of a free neutron in seconds.
+const neutronLifetime = 879.4
+
+// Particle is a species tracked through the early universe.
+type Particle struct {
+	Name          string
+	Mass          float64 // rest mass, kg
+	Charge        float64 // in units of ElementaryCharge
+	Lifetime      float64 // mean lifetime, s; +Inf for stable particles
+	Antimatter    bool
+	SelfConjugate bool // its own antiparticle, like the photon
+}
+
+// The standard particles. Take antiparticles from Antiparticle, so the
+// positron is Electron.Antiparticle().
+var (
+	Electron = Particle{Name: "electron", Mass: electronMass, Charge: -1, Lifetime: math.Inf(1)}
+	Proton   = Particle{Name: "proton", Mass: protonMass, Charge: 1, Lifetime: math.Inf(1)}
+	Neutron  = Particle{Name: "neutron", Mass: neutronMass, Charge: 0, Lifetime: neutronLifetime}
+	Photon   = Particle{Name: "photon", Mass: 0, Charge: 0, Lifetime: math.Inf(1), SelfConjugate: true}
+	Neutrino = Particle{Name: "neutrino", Mass: neutrinoMass, Charge: 0, Lifetime: math.Inf(1)}
+)
+
+// StandardParticles returns the standard particles in a fresh slice.
+func StandardParticles() []Particle {
+	return []Particle{Electron, Proton, Neutron, Photon, Neutrino}
+}
+
+// LookupParticle returns the standard particle with the given name.
+func LookupParticle(name string) (Particle, bool) {
+	for _, p := range StandardParticles() {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Particle{}, false
+}
+
+// Antiparticle returns p's antiparticle: the same mass and lifetime with
+// the opposite charge. A self-conjugate particle is its own antiparticle.
+func (p Particle) Antiparticle() Particle {
+	if p.SelfConjugate {
+		return p
+	}
+	p.Charge = -p.Charge
+	p.Antimatter = !p.Antimatter
+	return p
+}
+
+// IsAntiparticleOf reports whether p and other are a particle-antiparticle
+// pair.
+func (p Particle) IsAntiparticleOf(other Particle) bool {
+	return p == other.Antiparticle()
+}
+
+// Annihilate returns the energy in joules released when p meets other at
+// rest: both rest energies if they are a particle-antiparticle pair, and 0
+// otherwise.
+func (p Particle) Annihilate(other Particle) float64 {
+	if !p.IsAntiparticleOf(other) {
+		return 0
+	}
+	return RestEnergy(p.Mass) + RestEnergy(other.Mass)
+}
+
+// DecayProbability returns the probability that p decays within dtS
+// seconds, 1 - e^(-dt/τ). Stable particles never decay.
+func (p Particle) DecayProbability(dtS float64) float64 {
+	if dtS <= 0 || p.Lifetime <= 0 || math.IsInf(p.Lifetime, 1) {
+		return 0
+	}
+	return -math.Expm1(-dtS / p.Lifetime)
+}
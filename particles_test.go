@@ -0,0 +1,18 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNeutronDecayProbability(t *testing.T) {
+	if got, want := Neutron.DecayProbability(880), 1-1/math.E; math.Abs(got-want) > 1e-3 {
+		t.Errorf("P(decay within 880 s) = %g, want ≈1-1/e = %g", got, want)
+	}
+	if got := Proton.DecayProbability(1e30); got != 0 {
+		t.Errorf("proton decay probability %g, want 0", got)
+	}
+	if got := Neutron.DecayProbability(-1); got != 0 {
+		t.Errorf("decay probability over negative time %g, want 0", got)
+	}
+}
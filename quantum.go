@@ -0,0 +1,73 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// reducedPlanck is ħ = h/2π.
+const reducedPlanck = PlanckConstant / (2 * math.Pi)
+
+// vacuumPermittivity is ε₀ in F/m (CODATA 2018).
+const vacuumPermittivity = 8.8541878128e-12
+
+// FineStructureConstant is α = e²/(4πε₀ħc) ≈ 1/137.036, the strength of
+// the electromagnetic interaction, derived from the fundamental constants.
+const FineStructureConstant = ElementaryCharge * ElementaryCharge /
+	(4 * math.Pi * vacuumPermittivity * reducedPlanck * SpeedOfLight)
+
+// DeBroglieWavelength returns the de Broglie wavelength in metres of a
+// particle of massKg moving at velocityMs, h/(mv). It uses the Newtonian
+// momentum, so it is only good well below the speed of light. Non-positive
+// mass or velocity gives 0.
+func DeBroglieWavelength(massKg, velocityMs float64) float64 {
+	if massKg <= 0 || velocityMs <= 0 {
+		return 0
+	}
+	return PlanckConstant / (massKg * velocityMs)
+}
+
+// DeBroglieWavelengthChecked is DeBroglieWavelength but rejects
+// non-positive mass and velocity.
+func DeBroglieWavelengthChecked(massKg, velocityMs float64) (float64, error) {
+	if massKg <= 0 {
+		return 0, fmt.Errorf("de Broglie wavelength of %g kg: %w", massKg, ErrNonPositive)
+	}
+	if velocityMs <= 0 {
+		return 0, fmt.Errorf("de Broglie wavelength at %g m/s: %w", velocityMs, ErrNonPositive)
+	}
+	return DeBroglieWavelength(massKg, velocityMs), nil
+}
+
+// ComptonWavelength returns the Compton wavelength in metres of a particle
+// of massKg, h/(mc): about 2.43e-12 m for the electron. Below it a particle
+// cannot be localized without making pairs. Non-positive mass gives 0.
+func ComptonWavelength(massKg float64) float64 {
+	if massKg <= 0 {
+		return 0
+	}
+	return PlanckConstant / (massKg * SpeedOfLight)
+}
+
+// ComptonWavelengthChecked is ComptonWavelength but rejects non-positive
+// mass.
+func ComptonWavelengthChecked(massKg float64) (float64, error) {
+	if massKg <= 0 {
+		return 0, fmt.Errorf("compton wavelength of %g kg: %w", massKg, ErrNonPositive)
+	}
+	return ComptonWavelength(massKg), nil
+}
+
+// BohrRadius returns the Bohr radius in metres, ħ/(mₑcα) ≈ 5.29e-11 m: the
+// size of a hydrogen atom in its ground state.
+func BohrRadius() float64 {
+	return reducedPlanck / (electronMass * SpeedOfLight * FineStructureConstant)
+}
+
+// RydbergEnergy returns the Rydberg energy in joules, α²mₑc²/2 ≈ 13.6 eV:
+// the binding energy of ground-state hydrogen, whose level n lies at
+// -RydbergEnergy/n². It uses the electron mass rather than the reduced
+// mass, so it is high by about 1 part in 1836.
+func RydbergEnergy() float64 {
+	return FineStructureConstant * FineStructureConstant * RestEnergy(electronMass) / 2
+}
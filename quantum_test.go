@@ -0,0 +1,24 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFineStructureConstant(t *testing.T) {
+	if d := math.Abs(FineStructureConstant - 1/137.036); d > 1e-6 {
+		t.Errorf("α = %g, off 1/137.036 by %g", FineStructureConstant, d)
+	}
+	if got := BohrRadius(); math.Abs(got/5.29177e-11-1) > 1e-5 {
+		t.Errorf("Bohr radius %g m, want ≈5.29177e-11", got)
+	}
+	if got := RydbergEnergy() / ElementaryCharge; math.Abs(got-13.6057) > 1e-3 {
+		t.Errorf("Rydberg energy %g eV, want ≈13.6057", got)
+	}
+}
+
+func TestComptonWavelengthElectron(t *testing.T) {
+	if got := ComptonWavelength(electronMass); math.Abs(got/2.42631e-12-1) > 1e-5 {
+		t.Errorf("electron Compton wavelength %g m, want ≈2.43e-12", got)
+	}
+}
@@ -0,0 +1,126 @@
+package universe
+
+import "math"
+
+// StefanBoltzmannConstant is σ = 2π⁵k⁴/(15h³c²) in W·m⁻²·K⁻⁴, derived from
+// the fundamental constants.
+const StefanBoltzmannConstant = 2 * math.Pi * math.Pi * math.Pi * math.Pi * math.Pi *
+	BoltzmannConstant * BoltzmannConstant * BoltzmannConstant * BoltzmannConstant /
+	(15 * PlanckConstant * PlanckConstant * PlanckConstant * SpeedOfLight * SpeedOfLight)
+
+// maxPlanckExponent is the largest hc/(λkT) worth evaluating. Past it the
+// Wien tail is below float64 range and exp would overflow.
+const maxPlanckExponent = 700
+
+// SpectralRadiance returns the blackbody spectral radiance B(λ, T) from
+// Planck's law in W·sr⁻¹·m⁻³, for wavelength in meters and temperature in
+// kelvin. Non-positive inputs give 0.
+func SpectralRadiance(wavelengthM, tempK float64) float64 {
+	if wavelengthM <= 0 || tempK <= 0 {
+		return 0
+	}
+	x := PlanckConstant * SpeedOfLight / (wavelengthM * BoltzmannConstant * tempK)
+	if x > maxPlanckExponent {
+		return 0
+	}
+	l5 := math.Pow(wavelengthM, 5)
+	return 2 * PlanckConstant * SpeedOfLight * SpeedOfLight / l5 / math.Expm1(x)
+}
+
+// SpectralRadiance is the typed form of SpectralRadiance: the radiance of a
+// blackbody at t at wavelength l.
+func (t Kelvin) SpectralRadiance(l Meters) float64 {
+	return SpectralRadiance(float64(l), float64(t))
+}
+
+// Roots of x = n(1 - e⁻ˣ), the stationarity conditions of Planck's law per
+// unit wavelength (n = 5) and per unit frequency (n = 3).
+var (
+	wienWavelengthRoot = wienRoot(5)
+	wienFrequencyRoot  = wienRoot(3)
+)
+
+// wienRoot solves x = n(1 - e⁻ˣ) by fixed-point iteration, which contracts
+// quickly from x = n.
+func wienRoot(n float64) float64 {
+	x := n
+	for i := 0; i < 100; i++ {
+		next := n * -math.Expm1(-x)
+		if next == x {
+			break
+		}
+		x = next
+	}
+	return x
+}
+
+// PeakWavelength returns the wavelength in meters at which a blackbody at
+// tempK emits most strongly per unit wavelength, b/T by Wien's displacement
+// law. Non-positive temperatures give 0.
+func PeakWavelength(tempK float64) float64 {
+	if tempK <= 0 {
+		return 0
+	}
+	b := PlanckConstant * SpeedOfLight / (wienWavelengthRoot * BoltzmannConstant)
+	return b / tempK
+}
+
+// PeakWavelength is the typed form of PeakWavelength.
+func (t Kelvin) PeakWavelength() Meters {
+	return Meters(PeakWavelength(float64(t)))
+}
+
+// PeakFrequency returns the frequency in Hz at which a blackbody at tempK
+// emits most strongly per unit frequency. This peak is not at c divided by
+// PeakWavelength, since the two spectra weight the band differently.
+// Non-positive temperatures give 0.
+func PeakFrequency(tempK float64) float64 {
+	if tempK <= 0 {
+		return 0
+	}
+	return wienFrequencyRoot * BoltzmannConstant * tempK / PlanckConstant
+}
+
+// StellarLuminosity returns the total power in watts radiated by a
+// blackbody sphere of radius radiusM at tempK, L = 4πR²σT⁴. Negative radius
+// or temperature gives 0.
+func StellarLuminosity(radiusM, tempK float64) float64 {
+	if radiusM <= 0 || tempK <= 0 {
+		return 0
+	}
+	t2 := tempK * tempK
+	return 4 * math.Pi * radiusM * radiusM * StefanBoltzmannConstant * t2 * t2
+}
+
+// PhotonEnergy returns the energy in joules of a photon of frequencyHz, hν.
+// Non-positive frequencies give 0.
+func PhotonEnergy(frequencyHz float64) float64 {
+	if frequencyHz <= 0 {
+		return 0
+	}
+	return PlanckConstant * frequencyHz
+}
+
+// PhotonEnergyFromWavelength returns the energy in joules of a photon of
+// wavelengthM, hc/λ. Non-positive wavelengths give 0.
+func PhotonEnergyFromWavelength(wavelengthM float64) float64 {
+	if wavelengthM <= 0 {
+		return 0
+	}
+	return PlanckConstant * SpeedOfLight / wavelengthM
+}
+
+// PhotonEnergy is the typed form of PhotonEnergyFromWavelength: the energy
+// of a photon of wavelength l.
+func (l Meters) PhotonEnergy() Joules {
+	return Joules(PhotonEnergyFromWavelength(float64(l)))
+}
+
+// PhotonMomentum returns the momentum in kg·m/s of a photon of wavelengthM,
+// h/λ. Non-positive wavelengths give 0.
+func PhotonMomentum(wavelengthM float64) float64 {
+	if wavelengthM <= 0 {
+		return 0
+	}
+	return PlanckConstant / wavelengthM
+}
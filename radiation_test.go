@@ -0,0 +1,35 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpectralRadianceIntegratesToStefanBoltzmann(t *testing.T) {
+	for _, temp := range []float64{3000, 5800, 10000} {
+		radiance := func(l float64) float64 { return SpectralRadiance(l, temp) }
+		total, _ := integrate(radiance, 1e-9, 1e-3, 1e-10)
+		want := StefanBoltzmannConstant * math.Pow(temp, 4)
+		if ratio := math.Pi * total / want; math.Abs(ratio-1) > 0.02 {
+			t.Errorf("%g K: π∫B dλ / σT⁴ = %g", temp, ratio)
+		}
+	}
+}
+
+func TestPhotonEnergyWavelengthRoundTrip(t *testing.T) {
+	for _, l := range []float64{1e-12, 500e-9, 0.21, 1e3} {
+		e := PhotonEnergyFromWavelength(l)
+		if back := PlanckConstant * SpeedOfLight / e; math.Abs(back/l-1) > 1e-12 {
+			t.Errorf("λ = %g m → %g J → λ = %g m", l, e, back)
+		}
+		if f := PhotonEnergy(SpeedOfLight / l); math.Abs(f/e-1) > 1e-12 {
+			t.Errorf("λ = %g m: hν = %g J, hc/λ = %g J", l, f, e)
+		}
+		if p := PhotonMomentum(l) * SpeedOfLight; math.Abs(p/e-1) > 1e-12 {
+			t.Errorf("λ = %g m: pc = %g J, E = %g J", l, p, e)
+		}
+	}
+	if PhotonEnergyFromWavelength(0) != 0 || PhotonEnergy(-1) != 0 {
+		t.Error("non-positive inputs did not give 0")
+	}
+}
@@ -0,0 +1,54 @@
+package universe
+
+import "math/rand"
+
+// NewCosmicRand returns a random source seeded with UniverseSeed, the seed
+// of this repository's timeline. Every roll drawn from it is reproducible,
+// so changing UniverseSeed forks the timeline: the same constants, but a
+// different history of cosmic events.
+func NewCosmicRand() *rand.Rand {
+	return newRand(UniverseSeed)
+}
+
+func newRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// countingSource wraps the standard source and records its seed and how far
+// it has advanced. math/rand cannot serialize a source, but reseeding and
+// discarding the same number of values lands on the same position.
+type countingSource struct {
+	src   rand.Source64
+	seed  int64
+	draws uint64
+}
+
+func newCountingSource(seed int64) *countingSource {
+	return &countingSource{src: rand.NewSource(seed).(rand.Source64), seed: seed}
+}
+
+func (s *countingSource) Int63() int64 {
+	s.draws++
+	return s.src.Int63()
+}
+
+func (s *countingSource) Uint64() uint64 {
+	s.draws++
+	return s.src.Uint64()
+}
+
+// Seed restarts the source from seed. It keeps the seed, so a position
+// saved afterwards replays from the new seed rather than the original one.
+func (s *countingSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.seed = seed
+	s.draws = 0
+}
+
+// skip advances the source by n values. Int63 and Uint64 each advance the
+// underlying generator by one step, so either may be replayed with the other.
+func (s *countingSource) skip(n uint64) {
+	for ; n > 0; n-- {
+		s.Uint64()
+	}
+}
@@ -0,0 +1,121 @@
+package universe
+
+import (
+	"fmt"
+	"math"
+)
+
+// subluminal reports whether |v| is below the speed of light.
+func subluminal(velocityMs float64) bool {
+	return math.Abs(velocityMs) < SpeedOfLight
+}
+
+// dopplerFactor returns √((1+β)/(1-β)), the ratio of observed to emitted
+// wavelength for a source receding at β = v/c.
+func dopplerFactor(velocityMs float64) float64 {
+	beta := velocityMs / SpeedOfLight
+	return math.Sqrt((1 + beta) / (1 - beta))
+}
+
+// RelativisticDoppler returns the rest-frame wavelength of light observed at
+// observedWavelengthM from a source moving along the line of sight at
+// velocityMs, positive for recession. Speeds at or beyond c give 0.
+func RelativisticDoppler(observedWavelengthM, velocityMs float64) float64 {
+	if !subluminal(velocityMs) {
+		return 0
+	}
+	return observedWavelengthM / dopplerFactor(velocityMs)
+}
+
+// RelativisticDopplerChecked is RelativisticDoppler but rejects speeds at or
+// beyond c.
+func RelativisticDopplerChecked(observedWavelengthM, velocityMs float64) (float64, error) {
+	if !subluminal(velocityMs) {
+		return 0, fmt.Errorf("doppler shift at %g m/s: %w", velocityMs, ErrSuperluminal)
+	}
+	return RelativisticDoppler(observedWavelengthM, velocityMs), nil
+}
+
+// RelativisticRedshift returns the redshift z of a source moving along the
+// line of sight at velocityMs, positive for recession. It approaches v/c at
+// low speed. Speeds at or beyond c give 0.
+func RelativisticRedshift(velocityMs float64) float64 {
+	if !subluminal(velocityMs) {
+		return 0
+	}
+	// √((1+β)/(1-β)) = e^atanh(β); expm1 keeps z accurate when it is tiny.
+	return math.Expm1(math.Atanh(velocityMs / SpeedOfLight))
+}
+
+// RelativisticRedshiftChecked is RelativisticRedshift but rejects speeds at
+// or beyond c.
+func RelativisticRedshiftChecked(velocityMs float64) (float64, error) {
+	if !subluminal(velocityMs) {
+		return 0, fmt.Errorf("redshift at %g m/s: %w", velocityMs, ErrSuperluminal)
+	}
+	return RelativisticRedshift(velocityMs), nil
+}
+
+// RestEnergy returns the rest energy mc² of massKg in joules.
+func RestEnergy(massKg float64) float64 {
+	return massKg * SpeedOfLight * SpeedOfLight
+}
+
+// RestEnergy is the typed form of RestEnergy.
+func (m Kilograms) RestEnergy() Joules {
+	return Joules(RestEnergy(float64(m)))
+}
+
+// MassFromEnergy returns the mass in kg equivalent to joules, E/c².
+func MassFromEnergy(joules float64) float64 {
+	return joules / (SpeedOfLight * SpeedOfLight)
+}
+
+// Mass is the typed form of MassFromEnergy: the mass equivalent to e.
+func (e Joules) Mass() Kilograms {
+	return Kilograms(MassFromEnergy(float64(e)))
+}
+
+// RestEnergyEV returns the rest energy of massKg in electronvolts.
+func RestEnergyEV(massKg float64) float64 {
+	return RestEnergy(massKg) / ElementaryCharge
+}
+
+// LorentzFactor returns γ = 1/√(1-β²) for velocityMs. It is +Inf at or
+// beyond c, the limit as the speed approaches light.
+func LorentzFactor(velocityMs float64) float64 {
+	if !subluminal(velocityMs) {
+		return math.Inf(1)
+	}
+	beta := velocityMs / SpeedOfLight
+	return 1 / math.Sqrt(1-beta*beta)
+}
+
+// LorentzFactorChecked is LorentzFactor but rejects speeds at or beyond c.
+func LorentzFactorChecked(velocityMs float64) (float64, error) {
+	if !subluminal(velocityMs) {
+		return 0, fmt.Errorf("lorentz factor at %g m/s: %w", velocityMs, ErrSuperluminal)
+	}
+	return LorentzFactor(velocityMs), nil
+}
+
+// AddVelocities returns the relativistic sum (u+v)/(1+uv/c²) of two
+// collinear velocities in m/s. It composes rapidities rather than
+// evaluating the fraction, since tanh cannot round past 1 and so the sum of
+// two speeds below c never exceeds c. Speeds at or beyond c give 0.
+func AddVelocities(u, v float64) float64 {
+	if !subluminal(u) || !subluminal(v) {
+		return 0
+	}
+	return SpeedOfLight * math.Tanh(math.Atanh(u/SpeedOfLight)+math.Atanh(v/SpeedOfLight))
+}
+
+// AddVelocitiesChecked is AddVelocities but rejects speeds at or beyond c.
+func AddVelocitiesChecked(u, v float64) (float64, error) {
+	for _, w := range []float64{u, v} {
+		if !subluminal(w) {
+			return 0, fmt.Errorf("velocity addition at %g m/s: %w", w, ErrSuperluminal)
+		}
+	}
+	return AddVelocities(u, v), nil
+}
@@ -0,0 +1,37 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRelativisticRedshiftLowVelocity(t *testing.T) {
+	for _, v := range []float64{1, 1e3, 3e5} {
+		z := RelativisticRedshift(v)
+		beta := v / SpeedOfLight
+		// z = β + β²/2 + O(β³), so the relative gap from v/c is about β/2.
+		if rel := math.Abs(z/beta - 1); rel > beta {
+			t.Errorf("v = %g m/s: z = %g, v/c = %g", v, z, beta)
+		}
+	}
+	if got := RelativisticRedshift(SpeedOfLight); got != 0 {
+		t.Errorf("RelativisticRedshift(c) = %g, want 0", got)
+	}
+}
+
+func TestAddVelocitiesNeverExceedsC(t *testing.T) {
+	justUnder := math.Nextafter(SpeedOfLight, 0)
+	for _, u := range []float64{0.9 * SpeedOfLight, 0.999999 * SpeedOfLight, SpeedOfLight * (1 - 1e-15), justUnder} {
+		for _, v := range []float64{0.5 * SpeedOfLight, 0.999999 * SpeedOfLight, justUnder} {
+			if w := AddVelocities(u, v); w > SpeedOfLight {
+				t.Errorf("AddVelocities(%v, %v) = %v, above c", u, v, w)
+			}
+		}
+	}
+	if w := AddVelocities(10, 20); math.Abs(w-30) > 1e-9 {
+		t.Errorf("AddVelocities(10, 20) = %g, want ≈30", w)
+	}
+	if w := AddVelocities(0.5*SpeedOfLight, 0.5*SpeedOfLight); math.Abs(w/SpeedOfLight-0.8) > 1e-12 {
+		t.Errorf("c/2 + c/2 = %gc, want 0.8c", w/SpeedOfLight)
+	}
+}
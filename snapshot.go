@@ -0,0 +1,106 @@
+package universe
+
+import "math"
+
+// DefaultSnapshotEpsilon is the relative tolerance DiffSnapshots allows
+// before it reports two floating-point fields as different.
+const DefaultSnapshotEpsilon = 1e-12
+
+// Snapshot is a copy of an engine's state at one moment. It holds no
+// references into the engine, so it stays unchanged as the engine runs on.
+type Snapshot struct {
+	Cosmology   Cosmology
+	Seed        int64
+	Commit      int64
+	AgeMyr      float64
+	ScaleFactor float64
+	StellarMass float64 // solar masses formed by the present day
+	RandDraws   uint64  // values drawn from the random source so far
+	EventCount  int
+}
+
+// Snapshot returns the engine's current state.
+func (e *Engine) Snapshot() Snapshot {
+	return Snapshot{
+		Cosmology:   e.cosmo,
+		Seed:        e.src.seed,
+		Commit:      e.commit,
+		AgeMyr:      e.age,
+		ScaleFactor: e.ScaleFactor(),
+		StellarMass: e.stellarMass,
+		RandDraws:   e.src.draws,
+		EventCount:  len(e.events),
+	}
+}
+
+// FieldDiff is one field on which two snapshots disagree, with its value in
+// each and the difference B - A.
+type FieldDiff struct {
+	Field string
+	A, B  float64
+	Delta float64
+}
+
+// snapshotField is one comparable field of a pair of snapshots. Counters
+// carry their exact comparison; the rest are floats compared within a
+// tolerance.
+type snapshotField struct {
+	name    string
+	a, b    float64
+	counter bool
+	equal   bool // counters only
+}
+
+// snapshotFields lists the fields of a and b side by side.
+func snapshotFields(a, b Snapshot) []snapshotField {
+	float := func(name string, x, y float64) snapshotField {
+		return snapshotField{name: name, a: x, b: y}
+	}
+	counter := func(name string, x, y float64, equal bool) snapshotField {
+		return snapshotField{name: name, a: x, b: y, counter: true, equal: equal}
+	}
+	ca, cb := a.Cosmology, b.Cosmology
+	return []snapshotField{
+		counter("seed", float64(a.Seed), float64(b.Seed), a.Seed == b.Seed),
+		counter("commit_count", float64(a.Commit), float64(b.Commit), a.Commit == b.Commit),
+		float("age_myr", a.AgeMyr, b.AgeMyr),
+		float("scale_factor", a.ScaleFactor, b.ScaleFactor),
+		float("stellar_mass_solar", a.StellarMass, b.StellarMass),
+		counter("rand_draws", float64(a.RandDraws), float64(b.RandDraws), a.RandDraws == b.RandDraws),
+		counter("event_count", float64(a.EventCount), float64(b.EventCount), a.EventCount == b.EventCount),
+		float("h0", ca.H0, cb.H0),
+		float("omega_lambda", ca.OmegaLambda, cb.OmegaLambda),
+		float("omega_matter", ca.OmegaMatter, cb.OmegaMatter),
+		float("omega_baryon", ca.OmegaBaryon, cb.OmegaBaryon),
+		float("cmb_temp_today", ca.CMBTempToday, cb.CMBTempToday),
+		float("reionization_redshift", ca.ReionizationRedshift, cb.ReionizationRedshift),
+		float("inflation.start_age_myr", ca.Inflation.StartAgeMyr, cb.Inflation.StartAgeMyr),
+		float("inflation.end_age_myr", ca.Inflation.EndAgeMyr, cb.Inflation.EndAgeMyr),
+		float("inflation.total_e_folds", ca.Inflation.TotalEFolds, cb.Inflation.TotalEFolds),
+	}
+}
+
+// DiffSnapshots returns the fields on which a and b differ, in a fixed
+// order, treating floating-point fields as equal within
+// DefaultSnapshotEpsilon. Identical snapshots give an empty diff.
+func DiffSnapshots(a, b Snapshot) []FieldDiff {
+	return DiffSnapshotsEpsilon(a, b, DefaultSnapshotEpsilon)
+}
+
+// DiffSnapshotsEpsilon is DiffSnapshots with a relative tolerance of eps:
+// floating-point fields differ only when |a-b| > eps·max(|a|, |b|).
+// Counters such as the commit count and RNG position are always compared
+// exactly.
+func DiffSnapshotsEpsilon(a, b Snapshot, eps float64) []FieldDiff {
+	var diffs []FieldDiff
+	for _, f := range snapshotFields(a, b) {
+		same := f.equal
+		if !f.counter {
+			same = f.a == f.b || math.Abs(f.b-f.a) <= eps*max(math.Abs(f.a), math.Abs(f.b))
+		}
+		if !same {
+			diffs = append(diffs, FieldDiff{Field: f.name, A: f.a, B: f.b, Delta: f.b - f.a})
+		}
+	}
+	return diffs
+}
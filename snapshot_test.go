@@ -0,0 +1,52 @@
+package universe
+
+import "testing"
+
+func TestDiffSnapshotsIdentical(t *testing.T) {
+	a, b := NewEngine(1), NewEngine(1)
+	a.Step(100)
+	b.Step(100)
+	if d := DiffSnapshots(a.Snapshot(), b.Snapshot()); len(d) != 0 {
+		t.Errorf("identical timelines differ: %v", d)
+	}
+}
+
+func TestDiffSnapshotsCatchesOneStep(t *testing.T) {
+	a, b := NewEngine(1), NewEngine(1)
+	a.Step(100)
+	b.Step(100)
+	b.Step(1)
+	got := map[string]FieldDiff{}
+	for _, d := range DiffSnapshots(a.Snapshot(), b.Snapshot()) {
+		got[d.Field] = d
+	}
+	for _, field := range []string{"commit_count", "age_myr", "scale_factor"} {
+		if _, ok := got[field]; !ok {
+			t.Errorf("one extra step not caught in %s; diff %v", field, got)
+		}
+	}
+	if d := got["age_myr"]; d.Delta != 1 {
+		t.Errorf("age_myr delta %g, want 1", d.Delta)
+	}
+}
+
+func TestDiffSnapshotsEpsilon(t *testing.T) {
+	c := DefaultCosmology()
+	c.H0 *= 1 + 1e-14
+	a, b := NewEngine(1), NewEngineWithCosmology(1, c)
+	if d := DiffSnapshots(a.Snapshot(), b.Snapshot()); len(d) != 0 {
+		t.Errorf("rounding-level change in H0 reported: %v", d)
+	}
+	if d := DiffSnapshotsEpsilon(a.Snapshot(), b.Snapshot(), 0); len(d) == 0 {
+		t.Error("zero epsilon missed a change in H0")
+	}
+}
+
+func TestSnapshotUnchangedByLaterSteps(t *testing.T) {
+	e := NewEngine(1)
+	s := e.Snapshot()
+	e.Step(10)
+	if s.AgeMyr != 0 || s.Commit != BigBangCommit {
+		t.Errorf("snapshot changed as the engine stepped: %+v", s)
+	}
+}
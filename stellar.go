@@ -0,0 +1,127 @@
+package universe
+
+import "math"
+
+// solarMainSequenceLifetimeMyr is the hydrogen-burning lifetime of a
+// one-solar-mass star.
+const solarMainSequenceLifetimeMyr = 10000
+
+// MainSequenceLifetime returns the approximate hydrogen-burning lifetime in
+// Myr of a star of massSolar solar masses, 10000·M^-2.5. Heavier stars burn
+// out far sooner: ten solar masses lasts about 32 Myr. Non-positive mass
+// gives 0.
+func MainSequenceLifetime(massSolar float64) float64 {
+	if massSolar <= 0 {
+		return 0
+	}
+	return solarMainSequenceLifetimeMyr * math.Pow(massSolar, -2.5)
+}
+
+// HasDied reports whether a star of massSolar born at birthAgeMyr has left
+// the main sequence by currentAgeMyr.
+func HasDied(massSolar, birthAgeMyr, currentAgeMyr float64) bool {
+	if massSolar <= 0 {
+		return false
+	}
+	return currentAgeMyr >= birthAgeMyr+MainSequenceLifetime(massSolar)
+}
+
+// Structure constants for the compact-object mass limits.
+const (
+	// laneEmdenN3 is ω₃⁰, the dimensionless mass of an n = 3 polytrope.
+	laneEmdenN3 = 2.01824
+	// electronsPerNucleon is 1/μe for a helium, carbon or oxygen core.
+	electronsPerNucleon = 0.5
+	// tovCoefficient scales the Planck mass cubed over the neutron mass
+	// squared to the maximum neutron star mass. It stands in for the nuclear
+	// equation of state, calibrated so the standard constants give ≈2.17 M☉.
+	tovCoefficient = 1.1745
+)
+
+// planckMassCubed returns (ħc/G)^(3/2), the Planck mass cubed, which sets
+// the scale of every degenerate-star mass limit.
+func planckMassCubed() float64 {
+	return math.Pow(reducedPlanck*SpeedOfLight/GravitationalConst, 1.5)
+}
+
+// ChandrasekharMass is the maximum mass in kg that electron degeneracy
+// pressure can hold up, ≈1.44 M☉. It is derived from ħ, c, G and the
+// proton mass, so it moves with them.
+var ChandrasekharMass = laneEmdenN3 * math.Sqrt(3*math.Pi) / 2 * planckMassCubed() /
+	math.Pow(protonMass/electronsPerNucleon, 2)
+
+// TOVLimit is the Tolman-Oppenheimer-Volkoff limit, the maximum mass in kg
+// of a neutron star, ≈2.17 M☉. It scales with ħ, c, G and the neutron mass.
+var TOVLimit = tovCoefficient * planckMassCubed() / (neutronMass * neutronMass)
+
+// WillBecomeNeutronStar reports whether a collapsing stellar core of
+// coreMassKg is too heavy for a white dwarf but light enough to stop as a
+// neutron star.
+func WillBecomeNeutronStar(coreMassKg float64) bool {
+	return coreMassKg > ChandrasekharMass && coreMassKg <= TOVLimit
+}
+
+// WillBecomeBlackHole reports whether a collapsing stellar core of
+// coreMassKg exceeds the TOV limit and collapses to a black hole.
+func WillBecomeBlackHole(coreMassKg float64) bool {
+	return coreMassKg > TOVLimit
+}
+
+// RelativisticElectronDensity returns the electron number density in m⁻³,
+// about 5.9e35, at which the Fermi momentum reaches mₑc,
+// (mₑc/ħ)³/3π². Well below it degenerate electrons are non-relativistic;
+// well above it they are ultra-relativistic. White dwarfs near the
+// Chandrasekhar mass sit above it, which is why they can no longer
+// support themselves.
+func RelativisticElectronDensity() float64 {
+	k := electronMass * SpeedOfLight / reducedPlanck
+	return k * k * k / (3 * math.Pi * math.Pi)
+}
+
+// ElectronDegeneracyPressure returns the pressure in pascals of a
+// non-relativistic degenerate electron gas of numberDensity electrons per
+// m³, (3π²)^(2/3) ħ² n^(5/3) / 5mₑ. It overestimates the pressure above
+// RelativisticElectronDensity. Non-positive density gives 0.
+func ElectronDegeneracyPressure(numberDensity float64) float64 {
+	if numberDensity <= 0 {
+		return 0
+	}
+	return math.Pow(3*math.Pi*math.Pi, 2.0/3.0) * reducedPlanck * reducedPlanck /
+		(5 * electronMass) * math.Pow(numberDensity, 5.0/3.0)
+}
+
+// RelativisticElectronDegeneracyPressure returns the pressure in pascals of
+// an ultra-relativistic degenerate electron gas of numberDensity electrons
+// per m³, (3π²)^(1/3) ħc n^(4/3) / 4. It overestimates the pressure below
+// RelativisticElectronDensity. Non-positive density gives 0.
+func RelativisticElectronDegeneracyPressure(numberDensity float64) float64 {
+	if numberDensity <= 0 {
+		return 0
+	}
+	return math.Cbrt(3*math.Pi*math.Pi) * reducedPlanck * SpeedOfLight / 4 *
+		math.Pow(numberDensity, 4.0/3.0)
+}
+
+// DegenerateElectronPressure returns the pressure in pascals of a
+// degenerate electron gas of numberDensity electrons per m³ at any density,
+// Chandrasekhar's mₑ⁴c⁵/24π²ħ³ · [x(2x²-3)√(1+x²) + 3 asinh x] with
+// x = p_F/mₑc. It follows ElectronDegeneracyPressure at low density and
+// RelativisticElectronDegeneracyPressure at high density. Non-positive
+// density gives 0.
+func DegenerateElectronPressure(numberDensity float64) float64 {
+	if numberDensity <= 0 {
+		return 0
+	}
+	x := math.Cbrt(numberDensity / RelativisticElectronDensity())
+	var f float64
+	if x < 0.01 {
+		// The closed form cancels catastrophically here; use its series.
+		x2 := x * x
+		f = x2 * x2 * x * (8.0/5.0 - x2*(4.0/7.0-x2/3))
+	} else {
+		f = x*(2*x*x-3)*math.Sqrt(1+x*x) + 3*math.Asinh(x)
+	}
+	mc := electronMass * SpeedOfLight
+	return mc * mc * mc * mc * SpeedOfLight /
+		(24 * math.Pi * math.Pi * math.Pow(reducedPlanck, 3)) * f
+}
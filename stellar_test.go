@@ -0,0 +1,72 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMainSequenceLifetime(t *testing.T) {
+	if got := MainSequenceLifetime(1); math.Abs(got-10000) > 1 {
+		t.Errorf("1 M☉ lifetime %g Myr, want ≈10000", got)
+	}
+	if got := MainSequenceLifetime(10); got > 100 {
+		t.Errorf("10 M☉ lifetime %g Myr, want far shorter than the Sun's", got)
+	}
+	if !HasDied(10, 0, 100) || HasDied(1, 0, 100) {
+		t.Error("after 100 Myr a 10 M☉ star should have died and a 1 M☉ star not")
+	}
+}
+
+func TestChandrasekharMass(t *testing.T) {
+	if got := ChandrasekharMass / solarMass; math.Abs(got/1.44-1) > 0.01 {
+		t.Errorf("Chandrasekhar mass %g M☉, want within 1%% of 1.44", got)
+	}
+	if !WillBecomeNeutronStar(1.5*solarMass) || WillBecomeNeutronStar(1.4*solarMass) {
+		t.Error("the neutron star range does not start at the Chandrasekhar mass")
+	}
+}
+
+// logSlope returns d ln P / d ln n of p at n.
+func logSlope(p func(float64) float64, n float64) float64 {
+	const h = 1e-3
+	return (math.Log(p(n*math.Exp(h))) - math.Log(p(n*math.Exp(-h)))) / (2 * h)
+}
+
+func TestDegeneracyPressureScaling(t *testing.T) {
+	nc := RelativisticElectronDensity()
+	for _, tc := range []struct {
+		name string
+		p    func(float64) float64
+		n    float64
+		want float64
+	}{
+		{"non-relativistic", ElectronDegeneracyPressure, nc, 5.0 / 3},
+		{"relativistic", RelativisticElectronDegeneracyPressure, nc, 4.0 / 3},
+		{"exact, dilute", DegenerateElectronPressure, 1e-9 * nc, 5.0 / 3},
+		{"exact, dense", DegenerateElectronPressure, 1e9 * nc, 4.0 / 3},
+	} {
+		if got := logSlope(tc.p, tc.n); math.Abs(got-tc.want) > 1e-4 {
+			t.Errorf("%s: P ∝ n^%.6f, want n^%.6f", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDegenerateElectronPressureLimits(t *testing.T) {
+	nc := RelativisticElectronDensity()
+	if r := DegenerateElectronPressure(1e-9*nc) / ElectronDegeneracyPressure(1e-9*nc); math.Abs(r-1) > 1e-5 {
+		t.Errorf("dilute: exact/non-relativistic = %g", r)
+	}
+	if r := DegenerateElectronPressure(1e9*nc) / RelativisticElectronDegeneracyPressure(1e9*nc); math.Abs(r-1) > 1e-5 {
+		t.Errorf("dense: exact/relativistic = %g", r)
+	}
+}
+
+func TestDegenerateElectronPressureSeriesSwitch(t *testing.T) {
+	nc := RelativisticElectronDensity()
+	// x = 0.01 is n = 10⁻⁶ n_c; straddle it by one part in 10⁹ in x.
+	below := DegenerateElectronPressure(math.Pow(0.01*(1-1e-9), 3) * nc)
+	above := DegenerateElectronPressure(math.Pow(0.01*(1+1e-9), 3) * nc)
+	if rel := (above - below) / below; rel < 0 || rel > 1e-6 {
+		t.Errorf("pressure jumps by %g across the series switch", rel)
+	}
+}
@@ -0,0 +1,151 @@
+package universe
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// starFormationTimescaleMyr is τ in the declining star formation history
+// SFR ∝ e^(-t/τ) assumed for a galaxy.
+const starFormationTimescaleMyr = 4000
+
+// foe is 10^44 J, the kinetic energy a core-collapse supernova drives into
+// its ejecta.
+const foe = 1e44
+
+// coreCollapsePerSolarMass is the number of core-collapse supernovae per
+// solar mass of stars formed: the stars between 8 and 50 M☉ in a Salpeter
+// initial mass function spanning 0.1 to 100 M☉, about 0.007.
+var coreCollapsePerSolarMass = salpeterCoreCollapse()
+
+// salpeterCoreCollapse counts the stars of 8–50 M☉ per unit mass formed
+// under dN/dm ∝ m^-2.35.
+func salpeterCoreCollapse() float64 {
+	const slope = 2.35
+	number := (math.Pow(8, 1-slope) - math.Pow(50, 1-slope)) / (slope - 1)
+	mass := (math.Pow(0.1, 2-slope) - math.Pow(100, 2-slope)) / (slope - 2)
+	return number / mass
+}
+
+// SupernovaRate returns the expected number of core-collapse supernovae per
+// Myr in a galaxy that has formed stellarMassSolar solar masses of stars over
+// ageMyr under an exponentially declining star formation history. Massive
+// stars live only a few Myr, so the rate follows the current star formation
+// rate. Non-positive mass or age gives 0.
+func SupernovaRate(stellarMassSolar, ageMyr float64) float64 {
+	if stellarMassSolar <= 0 || ageMyr <= 0 {
+		return 0
+	}
+	sfr := stellarMassSolar / (starFormationTimescaleMyr * math.Expm1(ageMyr/starFormationTimescaleMyr))
+	return coreCollapsePerSolarMass * sfr
+}
+
+// SupernovaEnergy returns the kinetic energy in joules of one core-collapse
+// supernova, one foe. The collapse itself releases the neutron star's
+// binding energy, 3GM²/5R ≈ 3e46 J, but nearly all of it leaves as
+// neutrinos; the ejecta carry about a percent.
+func SupernovaEnergy() float64 {
+	return foe
+}
+
+// formedStellarMass returns the solar masses of stars the engine's galaxy has
+// formed by ageMyr, scaled so it reaches the engine's stellar mass at the
+// present day.
+func (e *Engine) formedStellarMass(ageMyr float64) float64 {
+	if ageMyr <= 0 {
+		return 0
+	}
+	return e.stellarMass * math.Expm1(-ageMyr/starFormationTimescaleMyr) /
+		math.Expm1(-e.cosmo.PresentAge()/starFormationTimescaleMyr)
+}
+
+// formationAge inverts formedStellarMass.
+func (e *Engine) formationAge(massSolar float64) float64 {
+	x := massSolar / e.stellarMass * -math.Expm1(-e.cosmo.PresentAge()/starFormationTimescaleMyr)
+	return -starFormationTimescaleMyr * math.Log1p(-x)
+}
+
+// maxSupernovaEvents is the most supernovae one step emits as separate
+// events. A step with more emits them as a single event carrying the count,
+// so a realistic galaxy does not flood the event log.
+const maxSupernovaEvents = 32
+
+// supernovae draws the supernovae that go off between fromMyr and toMyr,
+// oldest first. Each supernova is tied to a solar mass of star formation
+// drawn uniformly over the mass formed in the interval, which places it in
+// time exactly under the star formation history. Past maxSupernovaEvents
+// they are aggregated into one event at the time by which half the
+// interval's stars have formed.
+func (e *Engine) supernovae(fromMyr, toMyr float64) []Event {
+	if e.stellarMass <= 0 || toMyr <= fromMyr {
+		return nil
+	}
+	m0, m1 := e.formedStellarMass(fromMyr), e.formedStellarMass(toMyr)
+	n := poisson(e.rng, coreCollapsePerSolarMass*(m1-m0))
+	if n == 0 {
+		return nil
+	}
+	if n > maxSupernovaEvents {
+		age := e.formationAge((m0 + m1) / 2)
+		return []Event{e.supernova(age, n)}
+	}
+	ages := make([]float64, n)
+	for i := range ages {
+		ages[i] = e.formationAge(m0 + (m1-m0)*e.rng.Float64())
+	}
+	sort.Float64s(ages)
+	events := make([]Event, n)
+	for i, age := range ages {
+		events[i] = e.supernova(age, 1)
+	}
+	return events
+}
+
+// supernova builds the event for count supernovae at ageMyr.
+func (e *Engine) supernova(ageMyr float64, count int) Event {
+	return Event{Name: EventSupernova, AgeMyr: ageMyr, Redshift: e.cosmo.RedshiftFromAge(ageMyr), Count: count}
+}
+
+// poissonRejectionMean is the mean above which poisson switches from
+// Knuth's method, whose cost grows with the mean, to rejection sampling.
+const poissonRejectionMean = 10
+
+// poisson draws a Poisson-distributed count with the given mean from r. Small
+// means use Knuth's multiplication method; larger ones use Hörmann's
+// transformed rejection (PTRS, 1993), which takes a few draws at any mean.
+func poisson(r *rand.Rand, mean float64) int {
+	if mean <= 0 {
+		return 0
+	}
+	if mean < poissonRejectionMean {
+		n := 0
+		limit := math.Exp(-mean)
+		for p := r.Float64(); p > limit; p *= r.Float64() {
+			n++
+		}
+		return n
+	}
+	smu := math.Sqrt(mean)
+	b := 0.931 + 2.53*smu
+	a := -0.059 + 0.02483*b
+	invAlpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+	logMean := math.Log(mean)
+	for {
+		u := r.Float64() - 0.5
+		v := r.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + mean + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v*invAlpha/(a/(us*us)+b)) <= -mean+k*logMean-lg {
+			return int(k)
+		}
+	}
+}
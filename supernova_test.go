@@ -0,0 +1,124 @@
+package universe
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// supernovaCount totals the supernovae among events.
+func supernovaCount(events []Event) int {
+	n := 0
+	for _, ev := range events {
+		if ev.Name == EventSupernova {
+			n += ev.Count
+		}
+	}
+	return n
+}
+
+// expectedSupernovae integrates SupernovaRate over the engine galaxy's star
+// formation history from 0 to ageMyr.
+func expectedSupernovae(e *Engine, ageMyr float64) float64 {
+	rate := func(t float64) float64 {
+		return SupernovaRate(e.formedStellarMass(t), t)
+	}
+	n, _ := integrate(rate, 0, ageMyr, 1e-10)
+	return n
+}
+
+func TestSupernovaCountMatchesRateIntegral(t *testing.T) {
+	for _, mass := range []float64{1e5, 1e10} {
+		e := NewEngine(42)
+		e.SetStellarMass(mass)
+		if err := e.RunUntil(context.Background(), PresentAge(), 100); err != nil {
+			t.Fatal(err)
+		}
+		want := expectedSupernovae(e, PresentAge())
+		got := float64(supernovaCount(e.Events()))
+		if math.Abs(got-want) > 4*math.Sqrt(want) {
+			t.Errorf("mass %g M☉: %g supernovae, want %g ± %g", mass, got, want, 4*math.Sqrt(want))
+		}
+	}
+}
+
+func TestSupernovaEventsInOrder(t *testing.T) {
+	e := NewEngine(7)
+	e.SetStellarMass(1e5)
+	if err := e.RunUntil(context.Background(), PresentAge(), 50); err != nil {
+		t.Fatal(err)
+	}
+	events := e.Events()
+	for i := 1; i < len(events); i++ {
+		if events[i].AgeMyr < events[i-1].AgeMyr {
+			t.Fatalf("event %d (%s at %g Myr) before event %d (%s at %g Myr)",
+				i, events[i].Name, events[i].AgeMyr, i-1, events[i-1].Name, events[i-1].AgeMyr)
+		}
+	}
+}
+
+func TestSupernovaReproducible(t *testing.T) {
+	run := func() []Event {
+		e := NewEngine(42)
+		e.SetStellarMass(1e5)
+		for range 1380 {
+			e.Step(10)
+		}
+		return e.Events()
+	}
+	if a, b := run(), run(); !reflect.DeepEqual(a, b) {
+		t.Error("same seed gave different supernovae")
+	}
+}
+
+func TestSupernovaLargeGalaxyIsFast(t *testing.T) {
+	e := NewEngine(1)
+	e.SetStellarMass(1e11)
+	start := time.Now()
+	e.Step(PresentAge())
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("one step of a 1e11 M☉ galaxy took %v", d)
+	}
+	if n := len(e.Events()); n > len(cosmicEpochs(e.Cosmology()))+1 {
+		t.Errorf("one step emitted %d events, want the supernovae aggregated", n)
+	}
+	want := coreCollapsePerSolarMass * 1e11
+	if got := float64(supernovaCount(e.Events())); math.Abs(got-want) > 4*math.Sqrt(want) {
+		t.Errorf("%g supernovae, want %g ± %g", got, want, 4*math.Sqrt(want))
+	}
+}
+
+func TestPoissonMoments(t *testing.T) {
+	r := newRand(3)
+	for _, mean := range []float64{0.5, 5, 12, 400, 1e6} {
+		const draws = 20000
+		var sum, sum2 float64
+		for range draws {
+			k := float64(poisson(r, mean))
+			sum += k
+			sum2 += k * k
+		}
+		m := sum / draws
+		v := sum2/draws - m*m
+		if math.Abs(m-mean) > 5*math.Sqrt(mean/draws) {
+			t.Errorf("mean %g: sample mean %g", mean, m)
+		}
+		if math.Abs(v/mean-1) > 0.05 {
+			t.Errorf("mean %g: sample variance %g", mean, v)
+		}
+	}
+}
+
+func TestSupernovaRate(t *testing.T) {
+	if got := coreCollapsePerSolarMass; math.Abs(got-0.007) > 0.0005 {
+		t.Errorf("core-collapse supernovae per M☉ = %g, want ≈0.007", got)
+	}
+	if SupernovaRate(0, 1000) != 0 || SupernovaRate(1e10, 0) != 0 {
+		t.Error("SupernovaRate of an empty or unborn galaxy is not 0")
+	}
+	if got := SupernovaEnergy(); got != 1e44 {
+		t.Errorf("SupernovaEnergy() = %g J, want 1e44", got)
+	}
+}
@@ -0,0 +1,65 @@
+package universe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// timelineHeader names the columns written by WriteTimelineCSV.
+var timelineHeader = []string{"age_myr", "redshift", "scale_factor", "hubble_km_s_mpc", "cmb_temp_k"}
+
+// WriteTimelineCSV writes the expansion history of the engine's cosmology to
+// w as CSV: a header row, then one row per sample from fromAgeMyr to
+// toAgeMyr every dtMyr, with a shorter final step so the last row lands on
+// toAgeMyr. Each row gives the age in Myr, the redshift, the scale factor,
+// H in km/s/Mpc and the CMB temperature in K, to six significant figures.
+// The samples are computed from the cosmology rather than by stepping, so
+// the engine itself is left untouched. Like RunUntil it fails with
+// ErrStepTooSmall once dtMyr falls below the float64 resolution of the age,
+// and it rejects bounds that are not finite.
+func (e *Engine) WriteTimelineCSV(w io.Writer, fromAgeMyr, toAgeMyr, dtMyr float64) error {
+	if !(dtMyr > 0) {
+		return fmt.Errorf("timeline step of %g Myr: %w", dtMyr, ErrNonPositive)
+	}
+	if !finite(fromAgeMyr) || !finite(toAgeMyr) {
+		return fmt.Errorf("timeline from %g to %g Myr: %w", fromAgeMyr, toAgeMyr, ErrNonFinite)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(timelineHeader); err != nil {
+		return err
+	}
+	for age := fromAgeMyr; age <= toAgeMyr; age = min(age+dtMyr, toAgeMyr) {
+		a := e.cosmo.ScaleFactorDuringInflation(age)
+		z := 1/a - 1
+		h := math.Inf(1) // the Big Bang row, where H(z) would be Inf - Inf
+		if a > 0 {
+			h = e.cosmo.HubbleParameter(z)
+		}
+		row := []string{
+			formatSample(age),
+			formatSample(z),
+			formatSample(a),
+			formatSample(h),
+			formatSample(e.cosmo.CMBTemperature(z)),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+		if age == toAgeMyr {
+			break
+		}
+		if age+dtMyr == age {
+			return fmt.Errorf("timeline step of %g Myr at %g Myr: %w", dtMyr, age, ErrStepTooSmall)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatSample formats v like %.6g.
+func formatSample(v float64) string {
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
@@ -0,0 +1,52 @@
+package universe
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestWriteTimelineCSV(t *testing.T) {
+	e := NewEngine(0)
+	var b strings.Builder
+	if err := e.WriteTimelineCSV(&b, 0, 1000, 300); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(b.String())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ages := []string{"0", "300", "600", "900", "1000"}
+	if len(rows) != len(ages)+1 || strings.Join(rows[0], ",") != strings.Join(timelineHeader, ",") {
+		t.Fatalf("timeline CSV:\n%s", b.String())
+	}
+	for i, age := range ages {
+		if rows[i+1][0] != age {
+			t.Errorf("row %d at age %s, want %s", i+1, rows[i+1][0], age)
+		}
+	}
+	if e.Age() != 0 {
+		t.Errorf("writing the timeline moved the engine to %g Myr", e.Age())
+	}
+}
+
+func TestWriteTimelineCSVRejectsStalledSteps(t *testing.T) {
+	e := NewEngine(0)
+	for _, tc := range []struct {
+		from, to, dt float64
+		want         error
+	}{
+		{1e17, 2e17, 1, ErrStepTooSmall},
+		{0, math.Inf(1), 1, ErrNonFinite},
+		{math.NaN(), 10, 1, ErrNonFinite},
+		{0, 10, math.NaN(), ErrNonPositive},
+	} {
+		err := e.WriteTimelineCSV(io.Discard, tc.from, tc.to, tc.dt)
+		if !errors.Is(err, tc.want) {
+			t.Errorf("timeline from %g to %g every %g Myr: %v, want %v", tc.from, tc.to, tc.dt, err, tc.want)
+		}
+	}
+}
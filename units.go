@@ -0,0 +1,64 @@
+package universe
+
+// Unit conversions used to move between the engine's astronomical units
+// (Myr, Mpc, km/s) and the SI units the physical constants are given in.
+const (
+	secondsPerYear = 365.25 * 24 * 3600 // Julian year
+	secondsPerMyr  = 1e6 * secondsPerYear
+	metersPerAU    = 1.495978707e11
+	metersPerPc    = 3.0856775814913673e16
+	metersPerMpc   = 1e6 * metersPerPc
+
+	solarMass = 1.98847e30 // kg
+)
+
+// Typed quantities for the parts of the API where a unit mix-up is easy to
+// make. Each is a plain float64 underneath, so converting to and from the
+// bare float64 functions costs nothing. The typed form of a function is a
+// method on the quantity it starts from, such as
+// Kilograms.SchwarzschildRadius or Kelvin.PeakWavelength, and returns a
+// typed result where one fits.
+type (
+	Meters      float64
+	Kilograms   float64
+	Kelvin      float64
+	Seconds     float64
+	Joules      float64
+	Megaparsecs float64
+)
+
+// Kilometers returns n kilometres as Meters.
+func Kilometers(n float64) Meters { return Meters(n * 1e3) }
+
+// AU returns n astronomical units as Meters.
+func AU(n float64) Meters { return Meters(n * metersPerAU) }
+
+// Parsecs returns n parsecs as Meters.
+func Parsecs(n float64) Meters { return Meters(n * metersPerPc) }
+
+// SolarMass returns n solar masses as Kilograms.
+func SolarMass(n float64) Kilograms { return Kilograms(n * solarMass) }
+
+// Years returns n Julian years as Seconds.
+func Years(n float64) Seconds { return Seconds(n * secondsPerYear) }
+
+// Myr returns n million years as Seconds.
+func Myr(n float64) Seconds { return Seconds(n * secondsPerMyr) }
+
+// ElectronVolts returns n electronvolts as Joules.
+func ElectronVolts(n float64) Joules { return Joules(n * ElementaryCharge) }
+
+// Meters converts d to Meters.
+func (d Megaparsecs) Meters() Meters { return Meters(float64(d) * metersPerMpc) }
+
+// Megaparsecs converts d to Megaparsecs.
+func (d Meters) Megaparsecs() Megaparsecs { return Megaparsecs(float64(d) / metersPerMpc) }
+
+// SolarMasses returns m in solar masses.
+func (m Kilograms) SolarMasses() float64 { return float64(m) / solarMass }
+
+// Myr returns t in million years.
+func (t Seconds) Myr() float64 { return float64(t) / secondsPerMyr }
+
+// ElectronVolts returns e in electronvolts.
+func (e Joules) ElectronVolts() float64 { return float64(e) / ElementaryCharge }
@@ -0,0 +1,47 @@
+package universe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTypedFormsMatchFloat64(t *testing.T) {
+	sun := SolarMass(1)
+	earthOrbit := AU(1)
+	for _, tc := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"SchwarzschildRadius", float64(sun.SchwarzschildRadius()), SchwarzschildRadius(solarMass)},
+		{"EscapeVelocity", sun.EscapeVelocity(Kilometers(696000)), EscapeVelocity(solarMass, 6.96e8)},
+		{"OrbitalPeriod", float64(sun.OrbitalPeriod(earthOrbit)), OrbitalPeriod(metersPerAU, solarMass)},
+		{"SemiMajorAxis", float64(sun.SemiMajorAxis(Years(1))), SemiMajorAxis(secondsPerYear, solarMass)},
+		{"HawkingTemperature", float64(sun.HawkingTemperature()), HawkingTemperature(solarMass)},
+		{"EvaporationTime", float64(sun.EvaporationTime()), EvaporationTime(solarMass)},
+		{"RestEnergy", float64(sun.RestEnergy()), RestEnergy(solarMass)},
+		{"Mass", float64(sun.RestEnergy().Mass()), solarMass},
+		{"SpectralRadiance", Kelvin(5800).SpectralRadiance(Meters(5e-7)), SpectralRadiance(5e-7, 5800)},
+		{"PeakWavelength", float64(Kelvin(5800).PeakWavelength()), PeakWavelength(5800)},
+		{"PhotonEnergy", Meters(5e-7).PhotonEnergy().ElectronVolts(), PhotonEnergyFromWavelength(5e-7) / ElementaryCharge},
+		{"ComovingDistanceTo", float64(ComovingDistanceTo(1)), ComovingDistance(1)},
+	} {
+		if math.Abs(tc.got/tc.want-1) > 1e-15 {
+			t.Errorf("typed %s = %g, float64 form %g", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUnitConversions(t *testing.T) {
+	if got := Megaparsecs(1).Meters().Megaparsecs(); math.Abs(float64(got)-1) > 1e-15 {
+		t.Errorf("1 Mpc round trips to %g Mpc", got)
+	}
+	if got := Myr(1).Myr(); got != 1 {
+		t.Errorf("1 Myr round trips to %g Myr", got)
+	}
+	if got := SolarMass(2).SolarMasses(); got != 2 {
+		t.Errorf("2 M☉ round trips to %g M☉", got)
+	}
+	if got := ElectronVolts(13.6).ElectronVolts(); math.Abs(got-13.6) > 1e-14 {
+		t.Errorf("13.6 eV round trips to %g eV", got)
+	}
+}